@@ -0,0 +1,153 @@
+package e7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ArtifactsService handles communication with the artifact related
+// methods of the EpicSevenDB API.
+type ArtifactsService service
+
+// MaxArtifactLevel is the highest enhancement level of an artifact.
+const MaxArtifactLevel = 30
+
+// Artifact represents an Epic Seven artifact.
+type Artifact struct {
+	UUID   string `json:"_id,omitempty"`
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Rarity uint   `json:"rarity,omitempty"`
+	// Role is the only role that can equip the artifact. It is nil for
+	// artifacts that can be equipped by every role.
+	Role            *Role         `json:"role,omitempty"`
+	Stats           ArtifactStats `json:"stats,omitempty"`
+	Skill           ArtifactSkill `json:"skill,omitempty"`
+	LoreDescription []string      `json:"loreDescription,omitempty"`
+	Assets          Assets        `json:"assets,omitempty"`
+}
+
+// ArtifactStats represents an artifact's stats at enhancement level 0 and at
+// MaxArtifactLevel. Stats grow linearly in between.
+type ArtifactStats struct {
+	Base ArtifactStat `json:"base,omitempty"`
+	Max  ArtifactStat `json:"max,omitempty"`
+}
+
+// ArtifactStat represents the stats granted by an artifact.
+type ArtifactStat struct {
+	Attack uint `json:"atk,omitempty"`
+	Health uint `json:"hp,omitempty"`
+}
+
+// AtLevel returns the stats of an artifact enhanced to level. Levels are
+// clamped to the range [0, MaxArtifactLevel] and values are rounded down.
+func (s ArtifactStats) AtLevel(level int) ArtifactStat {
+	level = clampArtifactLevel(level)
+	lerp := func(base, max uint) uint {
+		if max < base {
+			return base
+		}
+		return base + (max-base)*uint(level)/MaxArtifactLevel
+	}
+	return ArtifactStat{
+		Attack: lerp(s.Base.Attack, s.Max.Attack),
+		Health: lerp(s.Base.Health, s.Max.Health),
+	}
+}
+
+// ArtifactSkill represents an artifact's skill. The description contains
+// placeholders for the values of the skill level reached.
+type ArtifactSkill struct {
+	Description string `json:"description,omitempty"`
+	// Levels contains the skill's values at each skill level, starting at
+	// skill level 1.
+	Levels []ArtifactSkillLevel `json:"enhancements,omitempty"`
+}
+
+// ArtifactSkillLevel represents the values of an artifact's skill at a skill
+// level.
+type ArtifactSkillLevel struct {
+	Values []float32 `json:"values,omitempty"`
+}
+
+// ValuesAt returns the skill values of an artifact enhanced to level. The
+// skill gains a level every three enhancement levels. It returns nil if the
+// skill has no levels.
+func (s ArtifactSkill) ValuesAt(level int) []float32 {
+	if len(s.Levels) == 0 {
+		return nil
+	}
+	i := clampArtifactLevel(level) / 3
+	if i >= len(s.Levels) {
+		i = len(s.Levels) - 1
+	}
+	return s.Levels[i].Values
+}
+
+func clampArtifactLevel(level int) int {
+	switch {
+	case level < 0:
+		return 0
+	case level > MaxArtifactLevel:
+		return MaxArtifactLevel
+	default:
+		return level
+	}
+}
+
+// ArtifactsResponse is an EpicSevenDB API response that contains a list
+// of artifacts.
+type ArtifactsResponse struct {
+	Results  []Artifact `json:"results,omitempty"`
+	Metadata Metadata   `json:"meta,omitempty"`
+}
+
+func (r *ArtifactsResponse) metadata() Metadata {
+	return r.Metadata
+}
+
+// GetByID fetches an artifact by ID. The ID is the artifact's name in
+// lowercase, hyphenated like hero IDs. e.g. Durandal would be durandal.
+//
+// If the artifact does not exist, the returned error matches
+// ErrArtifactNotFound.
+func (s *ArtifactsService) GetByID(ctx context.Context, artifact string) (*Artifact, *Response, error) {
+	u := fmt.Sprintf("artifact/%v", artifact)
+	req, err := s.client.NewRequest(http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(ArtifactsResponse)
+	resp, err := s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, resp, notFound(err, "artifact", artifact)
+	}
+
+	i, err := pickResult(resp, "artifact", artifact, len(response.Results), func(i int) string {
+		return response.Results[i].UUID
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response.Results[i], resp, nil
+}
+
+// List fetches all artifacts.
+func (s *ArtifactsService) List(ctx context.Context) ([]Artifact, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "artifact")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(ArtifactsResponse)
+	resp, err := s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Results, resp, nil
+}
@@ -0,0 +1,214 @@
+package e7
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestArtifactsService_GetByID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/artifact/a", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+		{
+			"results": [
+				{
+					"_id": "a",
+					"id": "1",
+					"name": "A",
+					"rarity": 5,
+					"role": "knight",
+					"stats": {
+						"base": {"atk": 57, "hp": 300},
+						"max": {"atk": 285, "hp": 1500}
+					},
+					"skill": {
+						"description": "Increases Defense by {{0}}.",
+						"enhancements": [{"values": [0.1]}, {"values": [0.11]}]
+					},
+					"assets": {"icon": "i"}
+				}
+			],
+			"meta": {
+				"requestDate": "date",
+				"apiVersion": "1"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Artifacts.GetByID(ctx, "a")
+	if err != nil {
+		t.Errorf("Artifacts.GetByID returned error: %v", err)
+	}
+
+	knight := Knight
+	want := &Artifact{
+		UUID:   "a",
+		ID:     "1",
+		Name:   "A",
+		Rarity: 5,
+		Role:   &knight,
+		Stats: ArtifactStats{
+			Base: ArtifactStat{Attack: 57, Health: 300},
+			Max:  ArtifactStat{Attack: 285, Health: 1500},
+		},
+		Skill: ArtifactSkill{
+			Description: "Increases Defense by {{0}}.",
+			Levels:      []ArtifactSkillLevel{{Values: []float32{0.1}}, {Values: []float32{0.11}}},
+		},
+		Assets: Assets{Icon: "i"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Artifacts.GetByID mismatch (-want +got):\n%s", diff)
+	}
+
+	// Test s.client.NewRequest failure
+	client.BaseURL.Path = ""
+	got, resp, err := client.Artifacts.GetByID(ctx, "a")
+	if got != nil {
+		t.Errorf("client.BaseURL.Path='' GetByID = %#v, want nil", got)
+	}
+	if resp != nil {
+		t.Errorf("client.BaseURL.Path='' GetByID resp = %#v, want nil", resp)
+	}
+	if err == nil {
+		t.Error("client.BaseURL.Path='' GetByID err = nil, want error")
+	}
+}
+
+func TestArtifactsService_GetByID_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/artifact/a", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/artifact/b", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": []}`)
+	})
+
+	ctx := context.Background()
+	for _, id := range []string{"a", "b"} {
+		_, _, err := client.Artifacts.GetByID(ctx, id)
+		if !errors.Is(err, ErrArtifactNotFound) {
+			t.Errorf("Artifacts.GetByID(%q) err = %v, want ErrArtifactNotFound", id, err)
+		}
+	}
+}
+
+func TestArtifactsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/artifact", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+		{
+			"results": [
+				{
+					"_id": "a",
+					"id": "1",
+					"name": "A"
+				}
+			],
+			"meta": {
+				"requestDate": "date",
+				"apiVersion": "1"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Artifacts.List(ctx)
+	if err != nil {
+		t.Errorf("Artifacts.List returned error: %v", err)
+	}
+
+	want := []Artifact{
+		{
+			UUID: "a",
+			ID:   "1",
+			Name: "A",
+		}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Artifacts.List mismatch (-want +got):\n%s", diff)
+	}
+
+	// Test s.client.NewRequest failure
+	client.BaseURL.Path = ""
+	got, resp, err := client.Artifacts.List(ctx)
+	if got != nil {
+		t.Errorf("client.BaseURL.Path='' List = %#v, want nil", got)
+	}
+	if resp != nil {
+		t.Errorf("client.BaseURL.Path='' List resp = %#v, want nil", resp)
+	}
+	if err == nil {
+		t.Error("client.BaseURL.Path='' List err = nil, want error")
+	}
+}
+
+func TestArtifactStats_AtLevel(t *testing.T) {
+	s := ArtifactStats{
+		Base: ArtifactStat{Attack: 57, Health: 300},
+		Max:  ArtifactStat{Attack: 285, Health: 1500},
+	}
+
+	tests := []struct {
+		level int
+		want  ArtifactStat
+	}{
+		{level: -1, want: ArtifactStat{Attack: 57, Health: 300}},
+		{level: 0, want: ArtifactStat{Attack: 57, Health: 300}},
+		{level: 15, want: ArtifactStat{Attack: 171, Health: 900}},
+		{level: 29, want: ArtifactStat{Attack: 277, Health: 1460}},
+		{level: 30, want: ArtifactStat{Attack: 285, Health: 1500}},
+		{level: 31, want: ArtifactStat{Attack: 285, Health: 1500}},
+	}
+
+	for _, tt := range tests {
+		got := s.AtLevel(tt.level)
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("ArtifactStats.AtLevel(%d) mismatch (-want +got):\n%s", tt.level, diff)
+		}
+	}
+}
+
+func TestArtifactSkill_ValuesAt(t *testing.T) {
+	s := ArtifactSkill{
+		Levels: []ArtifactSkillLevel{
+			{Values: []float32{1}},
+			{Values: []float32{2}},
+			{Values: []float32{3}},
+		},
+	}
+
+	tests := []struct {
+		level int
+		want  []float32
+	}{
+		{level: 0, want: []float32{1}},
+		{level: 2, want: []float32{1}},
+		{level: 3, want: []float32{2}},
+		{level: 30, want: []float32{3}},
+	}
+
+	for _, tt := range tests {
+		got := s.ValuesAt(tt.level)
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("ArtifactSkill.ValuesAt(%d) mismatch (-want +got):\n%s", tt.level, diff)
+		}
+	}
+
+	if got := (ArtifactSkill{}).ValuesAt(0); got != nil {
+		t.Errorf("ArtifactSkill{}.ValuesAt(0) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,74 @@
+package e7
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultBatchConcurrency is the number of concurrent requests made by batch
+// methods when BatchOptions.Concurrency is not set.
+const DefaultBatchConcurrency = 4
+
+// BatchOptions specifies the optional parameters to batch methods such as
+// HeroesService.GetByIDs.
+type BatchOptions struct {
+	// Concurrency is the maximum number of requests in flight. If it is not
+	// positive, DefaultBatchConcurrency is used.
+	Concurrency int
+}
+
+func (o *BatchOptions) concurrency() int {
+	if o == nil || o.Concurrency <= 0 {
+		return DefaultBatchConcurrency
+	}
+	return o.Concurrency
+}
+
+// dedupe returns the distinct values of ids in order of first appearance,
+// and for every element of ids the index of its value in the distinct
+// values.
+func dedupe(ids []string) (unique []string, index []int) {
+	seen := make(map[string]int, len(ids))
+	index = make([]int, len(ids))
+	for i, id := range ids {
+		j, ok := seen[id]
+		if !ok {
+			j = len(unique)
+			seen[id] = j
+			unique = append(unique, id)
+		}
+		index[i] = j
+	}
+	return unique, index
+}
+
+// runBatch calls fn for every index in [0, n) using at most concurrency
+// goroutines. Once ctx is done, no further calls are started and skipped is
+// called instead for the remaining indexes. It returns when every index has
+// been handled.
+func runBatch(ctx context.Context, n, concurrency int, fn func(i int), skipped func(i int)) {
+	if concurrency > n {
+		concurrency = n
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if ctx.Err() != nil {
+					skipped(i)
+					continue
+				}
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
@@ -0,0 +1,89 @@
+package e7
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDedupe(t *testing.T) {
+	unique, index := dedupe([]string{"a", "b", "a", "c", "b"})
+
+	if diff := cmp.Diff([]string{"a", "b", "c"}, unique); diff != "" {
+		t.Errorf("dedupe unique mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int{0, 1, 0, 2, 1}, index); diff != "" {
+		t.Errorf("dedupe index mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRunBatch_concurrency(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight, calls := 0, 0, 0
+
+	runBatch(context.Background(), 10, 3, func(i int) {
+		mu.Lock()
+		inFlight++
+		calls++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}, func(i int) {
+		t.Errorf("index %d skipped without cancellation", i)
+	})
+
+	if calls != 10 {
+		t.Errorf("runBatch made %d calls, want 10", calls)
+	}
+	if maxInFlight > 3 {
+		t.Errorf("runBatch ran %d calls concurrently, want at most 3", maxInFlight)
+	}
+}
+
+func TestRunBatch_canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var mu sync.Mutex
+	called, skipped := 0, 0
+
+	runBatch(ctx, 5, 1, func(i int) {
+		mu.Lock()
+		defer mu.Unlock()
+		called++
+		cancel()
+	}, func(i int) {
+		mu.Lock()
+		defer mu.Unlock()
+		skipped++
+	})
+
+	if called != 1 || skipped != 4 {
+		t.Errorf("runBatch called %d and skipped %d, want 1 and 4", called, skipped)
+	}
+}
+
+func TestBatchOptions_concurrency(t *testing.T) {
+	tests := []struct {
+		opts *BatchOptions
+		want int
+	}{
+		{opts: nil, want: DefaultBatchConcurrency},
+		{opts: &BatchOptions{}, want: DefaultBatchConcurrency},
+		{opts: &BatchOptions{Concurrency: 8}, want: 8},
+	}
+
+	for _, tt := range tests {
+		if got := tt.opts.concurrency(); got != tt.want {
+			t.Errorf("BatchOptions%+v.concurrency() = %d, want %d", tt.opts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package e7
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// XFromCache is the header set on responses served by a CacheTransport from
+// its cache, either because the cached response was still fresh or because
+// the server confirmed it with 304 Not Modified.
+const XFromCache = "X-From-Cache"
+
+// A Cache stores serialized HTTP responses by key. Implementations must be
+// safe for concurrent use.
+type Cache interface {
+	// Get returns the response stored for key, if any.
+	Get(key string) ([]byte, bool)
+	// Set stores the response for key.
+	Set(key string, resp []byte)
+	// Delete removes the response stored for key.
+	Delete(key string)
+}
+
+// WithCache enables conditional-request caching of GET responses in cache.
+// The client's transport is wrapped in a CacheTransport once all options
+// have been applied, so WithCache may be given before or after
+// WithHTTPClient.
+func WithCache(cache Cache) ClientOption {
+	return func(c *Client) error {
+		c.cache = cache
+		return nil
+	}
+}
+
+// MemoryCache is a Cache that keeps responses in memory.
+type MemoryCache struct {
+	mu    sync.RWMutex
+	items map[string][]byte
+}
+
+// NewMemoryCache returns a new, empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{items: make(map[string][]byte)}
+}
+
+// Get returns the response stored for key, if any.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	resp, ok := c.items[key]
+	return resp, ok
+}
+
+// Set stores the response for key.
+func (c *MemoryCache) Set(key string, resp []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = resp
+}
+
+// Delete removes the response stored for key.
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+}
+
+// DiskCache is a Cache that stores each response in its own file in a
+// directory. Errors while reading or writing files are treated as cache
+// misses.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a DiskCache that stores responses in dir. The
+// directory is created when the first response is stored.
+func NewDiskCache(dir string) *DiskCache {
+	return &DiskCache{dir: dir}
+}
+
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get returns the response stored for key, if any.
+func (c *DiskCache) Get(key string) ([]byte, bool) {
+	resp, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return resp, true
+}
+
+// Set stores the response for key. The file is written atomically so that
+// concurrent readers never observe a partial response.
+func (c *DiskCache) Set(key string, resp []byte) {
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return
+	}
+	f, err := ioutil.TempFile(c.dir, "tmp-")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(resp)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), c.path(key))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+// Delete removes the response stored for key.
+func (c *DiskCache) Delete(key string) {
+	os.Remove(c.path(key))
+}
+
+// CacheTransport is an http.RoundTripper that caches GET responses. Fresh
+// responses, as determined by their Cache-Control max-age or Expires
+// headers, are served from the cache without contacting the server. Stale
+// responses are revalidated with If-None-Match and If-Modified-Since, and
+// served from the cache if the server replies 304 Not Modified.
+//
+// Responses served from the cache have the XFromCache header set. Responses
+// marked no-store are never cached, and requests or responses marked
+// no-cache are always revalidated. A stored response is removed when the
+// server replies 404 Not Found, 410 Gone, or with a response that cannot be
+// cached; it is kept on temporary errors such as 429 or 5xx.
+type CacheTransport struct {
+	// Cache stores the responses.
+	Cache Cache
+
+	// Transport makes the underlying requests. If nil, http.DefaultTransport
+	// is used.
+	Transport http.RoundTripper
+}
+
+func (t *CacheTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// cacheKey returns the key under which the response to req is stored. The
+// key is the full URL, so it includes the response language.
+func cacheKey(req *http.Request) string {
+	return req.URL.String()
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return t.transport().RoundTrip(req)
+	}
+
+	key := cacheKey(req)
+	reqCC := parseCacheControl(req.Header)
+	if _, ok := reqCC["no-store"]; ok {
+		return t.transport().RoundTrip(req)
+	}
+
+	cached := t.load(key, req)
+	if cached != nil {
+		if _, noCache := reqCC["no-cache"]; !noCache && fresh(cached.Header, time.Now()) {
+			cached.Header.Set(XFromCache, "1")
+			return cached, nil
+		}
+
+		req = req.Clone(req.Context())
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := cached.Header.Get("Last-Modified"); lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := t.transport().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		// Headers of a 304 response update those of the stored response.
+		for _, h := range []string{"Cache-Control", "Date", "ETag", "Expires", "Last-Modified"} {
+			if v := resp.Header.Get(h); v != "" {
+				cached.Header.Set(h, v)
+			}
+		}
+		t.store(key, cached)
+		cached.Header.Set(XFromCache, "1")
+		return cached, nil
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if cacheable(resp.Header) {
+			t.store(key, resp)
+		} else if cached != nil {
+			t.Cache.Delete(key)
+		}
+	case http.StatusNotFound, http.StatusGone:
+		if cached != nil {
+			t.Cache.Delete(key)
+		}
+	default:
+		// Other responses, such as 429 or 5xx, are temporary; the stored
+		// response is kept so that it can be revalidated later.
+	}
+	return resp, nil
+}
+
+// load returns the response stored for key, or nil if there is none or it
+// cannot be parsed.
+func (t *CacheTransport) load(key string, req *http.Request) *http.Response {
+	b, ok := t.Cache.Get(key)
+	if !ok {
+		return nil
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(b)), req)
+	if err != nil {
+		t.Cache.Delete(key)
+		return nil
+	}
+	return resp
+}
+
+// store serializes resp into the cache under key. The body of resp is
+// consumed and replaced, so resp can still be read by the caller.
+func (t *CacheTransport) store(key string, resp *http.Response) {
+	b, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return
+	}
+	t.Cache.Set(key, b)
+}
+
+// parseCacheControl parses the Cache-Control header of h into a map of
+// directives to their, possibly empty, values.
+func parseCacheControl(h http.Header) map[string]string {
+	cc := make(map[string]string)
+	for _, part := range strings.Split(h.Get("Cache-Control"), ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if i := strings.Index(part, "="); i >= 0 {
+			cc[strings.ToLower(part[:i])] = strings.Trim(part[i+1:], `" `)
+		} else {
+			cc[strings.ToLower(part)] = ""
+		}
+	}
+	return cc
+}
+
+// cacheable reports whether a response with headers h may be stored.
+// Responses that are neither fresh for some time nor revalidatable are not
+// worth storing.
+func cacheable(h http.Header) bool {
+	cc := parseCacheControl(h)
+	if _, ok := cc["no-store"]; ok {
+		return false
+	}
+	if h.Get("ETag") != "" || h.Get("Last-Modified") != "" {
+		return true
+	}
+	return fresh(h, time.Now())
+}
+
+// fresh reports whether a stored response with headers h can be served at
+// now without revalidation.
+func fresh(h http.Header, now time.Time) bool {
+	cc := parseCacheControl(h)
+	if _, ok := cc["no-cache"]; ok {
+		return false
+	}
+	date, err := http.ParseTime(h.Get("Date"))
+	if err != nil {
+		return false
+	}
+
+	var lifetime time.Duration
+	if v, ok := cc["max-age"]; ok {
+		secs, err := strconv.Atoi(v)
+		if err != nil {
+			return false
+		}
+		lifetime = time.Duration(secs) * time.Second
+	} else if v := h.Get("Expires"); v != "" {
+		expires, err := http.ParseTime(v)
+		if err != nil {
+			return false
+		}
+		lifetime = expires.Sub(date)
+	} else {
+		return false
+	}
+	return now.Sub(date) < lifetime
+}
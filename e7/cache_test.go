@@ -0,0 +1,291 @@
+package e7
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func setupCache() (client *Client, mux *http.ServeMux, teardown func()) {
+	client, mux, _, teardown = setup()
+	client.client.Transport = &CacheTransport{Cache: NewMemoryCache()}
+	return client, mux, teardown
+}
+
+func TestCacheTransport_etag(t *testing.T) {
+	client, mux, teardown := setupCache()
+	defer teardown()
+
+	calls, notModified := 0, 0
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"results": [{"_id": "h", "name": "H"}]}`)
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		got, resp, err := client.Heroes.List(ctx)
+		if err != nil {
+			t.Fatalf("Heroes.List returned error: %v", err)
+		}
+		if want := []Hero{{UUID: "h", Name: "H"}}; !cmp.Equal(want, got) {
+			t.Errorf("Heroes.List #%d mismatch (-want +got):\n%s", i, cmp.Diff(want, got))
+		}
+		if want := i > 0; resp.FromCache != want {
+			t.Errorf("Heroes.List #%d FromCache = %v, want %v", i, resp.FromCache, want)
+		}
+	}
+
+	if calls != 2 || notModified != 1 {
+		t.Errorf("server was called %d times with %d revalidations, want 2 and 1", calls, notModified)
+	}
+}
+
+func TestCacheTransport_lastModified(t *testing.T) {
+	client, mux, teardown := setupCache()
+	defer teardown()
+
+	lastModified := time.Date(2020, time.August, 22, 0, 0, 0, 0, time.UTC).Format(http.TimeFormat)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		req, _ := client.NewRequest(http.MethodGet, ".")
+		body := new(struct{ A string })
+		resp, err := client.Do(context.Background(), req, body)
+		if err != nil {
+			t.Fatalf("Do returned unexpected error: %v", err)
+		}
+		if body.A != "a" {
+			t.Errorf("Do #%d body was %q, want %q", i, body.A, "a")
+		}
+		if want := i > 0; resp.FromCache != want {
+			t.Errorf("Do #%d FromCache = %v, want %v", i, resp.FromCache, want)
+		}
+	}
+}
+
+func TestCacheTransport_maxAge(t *testing.T) {
+	client, mux, teardown := setupCache()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Cache-Control", "public, max-age=3600")
+		fmt.Fprint(w, `{}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		req, _ := client.NewRequest(http.MethodGet, ".")
+		if _, err := client.Do(context.Background(), req, nil); err != nil {
+			t.Fatalf("Do returned unexpected error: %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("server was called %d times, want 1", calls)
+	}
+}
+
+func TestCacheTransport_noStore(t *testing.T) {
+	client, mux, teardown := setupCache()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("If-None-Match") != "" {
+			t.Error("no-store response was revalidated")
+		}
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		req, _ := client.NewRequest(http.MethodGet, ".")
+		resp, err := client.Do(context.Background(), req, nil)
+		if err != nil {
+			t.Fatalf("Do returned unexpected error: %v", err)
+		}
+		if resp.FromCache {
+			t.Errorf("Do #%d FromCache = true, want false", i)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("server was called %d times, want 2", calls)
+	}
+}
+
+func TestCacheTransport_keepOnTemporaryError(t *testing.T) {
+	client, mux, teardown := setupCache()
+	defer teardown()
+
+	calls, notModified := 0, 0
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 2 {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"results": [{"_id": "h", "name": "H"}]}`)
+	})
+
+	ctx := context.Background()
+	if _, _, err := client.Heroes.List(ctx); err != nil {
+		t.Fatalf("Heroes.List returned error: %v", err)
+	}
+	if _, _, err := client.Heroes.List(ctx); err == nil {
+		t.Fatal("Heroes.List returned no error for 503 response")
+	}
+	got, resp, err := client.Heroes.List(ctx)
+	if err != nil {
+		t.Fatalf("Heroes.List returned error: %v", err)
+	}
+	if want := []Hero{{UUID: "h", Name: "H"}}; !cmp.Equal(want, got) {
+		t.Errorf("Heroes.List mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	if !resp.FromCache || notModified != 1 {
+		t.Errorf("cached response was not revalidated after 503: FromCache = %v, revalidations = %d", resp.FromCache, notModified)
+	}
+}
+
+func TestCacheTransport_deleteOnNotFound(t *testing.T) {
+	client, mux, teardown := setupCache()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 2 {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("If-None-Match") != "" {
+			t.Errorf("request #%d was revalidated after 404", calls)
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		req, _ := client.NewRequest(http.MethodGet, ".")
+		client.Do(context.Background(), req, nil)
+	}
+	if calls != 3 {
+		t.Errorf("server was called %d times, want 3", calls)
+	}
+}
+
+func TestCacheTransport_language(t *testing.T) {
+	client, mux, teardown := setupCache()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "max-age=3600")
+		fmt.Fprintf(w, `{"A":%q}`, r.URL.Query().Get("lang"))
+	})
+
+	for _, lang := range []Language{English, Korean, English} {
+		req, _ := client.NewRequest(http.MethodGet, ".")
+		body := new(struct{ A string })
+		ctx := ContextWithLanguage(context.Background(), lang)
+		if _, err := client.Do(ctx, req, body); err != nil {
+			t.Fatalf("Do returned unexpected error: %v", err)
+		}
+		if body.A != string(lang) {
+			t.Errorf("Do with language %v returned body for %q", lang, body.A)
+		}
+	}
+}
+
+func TestFresh(t *testing.T) {
+	date := time.Date(2020, time.August, 22, 0, 0, 0, 0, time.UTC)
+	now := date.Add(time.Minute)
+
+	tests := []struct {
+		header http.Header
+		want   bool
+	}{
+		{header: http.Header{}, want: false},
+		{header: http.Header{"Cache-Control": {"max-age=3600"}}, want: false},
+		{header: http.Header{"Date": {date.Format(http.TimeFormat)}, "Cache-Control": {"max-age=3600"}}, want: true},
+		{header: http.Header{"Date": {date.Format(http.TimeFormat)}, "Cache-Control": {"max-age=30"}}, want: false},
+		{header: http.Header{"Date": {date.Format(http.TimeFormat)}, "Cache-Control": {"no-cache, max-age=3600"}}, want: false},
+		{header: http.Header{"Date": {date.Format(http.TimeFormat)}, "Expires": {date.Add(time.Hour).Format(http.TimeFormat)}}, want: true},
+	}
+
+	for _, tt := range tests {
+		if got := fresh(tt.header, now); got != tt.want {
+			t.Errorf("fresh(%v) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
+
+func testCache(t *testing.T, c Cache) {
+	t.Helper()
+
+	if _, ok := c.Get("k"); ok {
+		t.Error("Get on empty cache returned ok = true")
+	}
+	c.Set("k", []byte("v"))
+	if got, ok := c.Get("k"); !ok || string(got) != "v" {
+		t.Errorf("Get = %q, %v, want %q, true", got, ok, "v")
+	}
+	c.Delete("k")
+	if _, ok := c.Get("k"); ok {
+		t.Error("Get after Delete returned ok = true")
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	testCache(t, NewMemoryCache())
+}
+
+func TestDiskCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "e7cache")
+	if err != nil {
+		t.Fatalf("ioutil.TempDir returned error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	testCache(t, NewDiskCache(dir))
+}
+
+func TestWithCache(t *testing.T) {
+	cache := NewMemoryCache()
+	transport := &http.Transport{}
+	c := NewClient(WithCache(cache), WithHTTPClient(&http.Client{Transport: transport}))
+
+	ct, ok := c.client.Transport.(*CacheTransport)
+	if !ok {
+		t.Fatalf("NewClient transport is %T, want *CacheTransport", c.client.Transport)
+	}
+	if ct.Cache != cache || ct.Transport != transport {
+		t.Errorf("CacheTransport = %+v, want cache %v wrapping %v", ct, cache, transport)
+	}
+}
@@ -0,0 +1,162 @@
+package e7
+
+import "sort"
+
+// Value returns the morale reaction to topic t.
+func (v CampingValues) Value(t Topic) int {
+	switch t {
+	case Criticism:
+		return v.Criticism
+	case RealityCheck:
+		return v.RealityCheck
+	case HeroicTale:
+		return v.HeroicTale
+	case ComfortingCheer:
+		return v.ComfortingCheer
+	case CuteCheer:
+		return v.CuteCheer
+	case HeroicCheer:
+		return v.HeroicCheer
+	case SadMemory:
+		return v.SadMemory
+	case JoyfulMemory:
+		return v.JoyfulMemory
+	case HappyMemory:
+		return v.HappyMemory
+	case UniqueComment:
+		return v.UniqueComment
+	case SelfIndulgent:
+		return v.SelfIndulgent
+	case Occult:
+		return v.Occult
+	case Myth:
+		return v.Myth
+	case BizarreStory:
+		return v.BizarreStory
+	case FoodStory:
+		return v.FoodStory
+	case HorrorStory:
+		return v.HorrorStory
+	case Gossip:
+		return v.Gossip
+	case Dream:
+		return v.Dream
+	case Advice:
+		return v.Advice
+	case Complain:
+		return v.Complain
+	case Belief:
+		return v.Belief
+	case InterestingStory:
+		return v.InterestingStory
+	default:
+		return 0
+	}
+}
+
+// CampChoice is a topic brought up at camp by the hero of a party slot.
+type CampChoice struct {
+	// Slot is the slot of the hero, from 1 to PartySize.
+	Slot  int
+	Hero  *Hero
+	Topic Topic
+}
+
+// CampResult is a pair of topics brought up at camp and the morale they
+// give the party.
+type CampResult struct {
+	Choices [2]CampChoice
+	Morale  int
+}
+
+// CampOptions returns every pair of topics the party can bring up at camp,
+// with the morale each gives, best first. Pairs with equal morale are in
+// slot order.
+//
+// Two different heroes each bring up one of their topics, and a topic can
+// only be brought up once. Every hero of the party, including the one
+// bringing it up, reacts to a topic with the morale of its Camping.Values.
+func (p Party) CampOptions() []CampResult {
+	var results []CampResult
+	for i := range p {
+		for j := i + 1; j < len(p); j++ {
+			if p[i].Hero == nil || p[j].Hero == nil {
+				continue
+			}
+			for _, ti := range p[i].Hero.Camping.Topics {
+				for _, tj := range p[j].Hero.Camping.Topics {
+					if ti == tj {
+						continue
+					}
+					results = append(results, CampResult{
+						Choices: [2]CampChoice{
+							{Slot: i + 1, Hero: p[i].Hero, Topic: ti},
+							{Slot: j + 1, Hero: p[j].Hero, Topic: tj},
+						},
+						Morale: p.reactions(ti) + p.reactions(tj),
+					})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Morale > results[j].Morale
+	})
+	return results
+}
+
+// reactions returns the morale the party gives topic t.
+func (p Party) reactions(t Topic) int {
+	morale := 0
+	for _, m := range p {
+		if m.Hero != nil {
+			morale += m.Hero.Camping.Values.Value(t)
+		}
+	}
+	return morale
+}
+
+// BestCamp returns the pair of topics giving the party the most morale at
+// camp. See CampOptions. The returned bool is false if no two heroes of the
+// party can bring up different topics.
+func (p Party) BestCamp() (CampResult, bool) {
+	options := p.CampOptions()
+	if len(options) == 0 {
+		return CampResult{}, false
+	}
+	return options[0], true
+}
+
+// BestCampCompanion returns the hero of roster that, added to the three
+// members, gives the party the most morale at camp, along with the best
+// topics of that party. Heroes of roster that are already members are
+// skipped, and ties are broken by roster order. The returned bool is false
+// if no hero of roster can camp with the members.
+func BestCampCompanion(members [PartySize - 1]*Hero, roster []*Hero) (*Hero, CampResult, bool) {
+	var (
+		best       *Hero
+		bestResult CampResult
+	)
+	for _, h := range roster {
+		if h == nil || isMember(members[:], h) {
+			continue
+		}
+		p := Party{{Hero: members[0]}, {Hero: members[1]}, {Hero: members[2]}, {Hero: h}}
+		result, ok := p.BestCamp()
+		if ok && (best == nil || result.Morale > bestResult.Morale) {
+			best, bestResult = h, result
+		}
+	}
+	return best, bestResult, best != nil
+}
+
+// isMember reports whether h is one of members, by pointer or UUID.
+func isMember(members []*Hero, h *Hero) bool {
+	for _, m := range members {
+		if m != nil && (m == h || m.UUID != "" && m.UUID == h.UUID) {
+			return true
+		}
+	}
+	return false
+}
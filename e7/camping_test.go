@@ -0,0 +1,114 @@
+package e7
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func campingTestHeroes() (a, b, c, d *Hero) {
+	a = &Hero{UUID: "a", Camping: Camping{
+		Topics: []Topic{Criticism, Myth},
+		Values: CampingValues{Criticism: 10, Myth: -5, Advice: 3},
+	}}
+	b = &Hero{UUID: "b", Camping: Camping{
+		Topics: []Topic{Myth, Advice},
+		Values: CampingValues{Criticism: 20, Myth: 15, Advice: -10},
+	}}
+	c = &Hero{UUID: "c", Camping: Camping{
+		Topics: []Topic{Advice, Gossip},
+		Values: CampingValues{Criticism: -5, Myth: 5, Advice: 8, Gossip: 2},
+	}}
+	d = &Hero{UUID: "d", Camping: Camping{
+		Topics: []Topic{Gossip, Criticism},
+		Values: CampingValues{Criticism: 50, Gossip: 1},
+	}}
+	return a, b, c, d
+}
+
+func TestCampingValues_Value(t *testing.T) {
+	v := CampingValues{RealityCheck: 1, InterestingStory: -2}
+	if got := v.Value(RealityCheck); got != 1 {
+		t.Errorf("CampingValues.Value(RealityCheck) = %d, want 1", got)
+	}
+	if got := v.Value(InterestingStory); got != -2 {
+		t.Errorf("CampingValues.Value(InterestingStory) = %d, want -2", got)
+	}
+	if got := v.Value(Topic(-1)); got != 0 {
+		t.Errorf("CampingValues.Value(-1) = %d, want 0", got)
+	}
+}
+
+func TestParty_BestCamp(t *testing.T) {
+	a, b, c, d := campingTestHeroes()
+	p := Party{{Hero: a}, {Hero: b}, {Hero: c}, {Hero: d}}
+
+	got, ok := p.BestCamp()
+	if !ok {
+		t.Fatal("Party.BestCamp ok = false, want true")
+	}
+	// Criticism from a, 10 + 20 - 5 + 50, and Myth from b, -5 + 15 + 5 + 0.
+	// Criticism from both a and d would give more, but a topic can only be
+	// brought up once.
+	want := CampResult{
+		Choices: [2]CampChoice{
+			{Slot: 1, Hero: a, Topic: Criticism},
+			{Slot: 2, Hero: b, Topic: Myth},
+		},
+		Morale: 90,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Party.BestCamp mismatch (-want +got):\n%s", diff)
+	}
+
+	options := p.CampOptions()
+	// Every pair of heroes has 2*2 pairs of topics, less the four pairs
+	// sharing a topic: a-b Myth, a-d Criticism, b-c Advice and c-d Gossip.
+	if got, want := len(options), 6*2*2-4; got != want {
+		t.Errorf("Party.CampOptions returned %d options, want %d", got, want)
+	}
+	for i := 1; i < len(options); i++ {
+		if options[i].Morale > options[i-1].Morale {
+			t.Fatalf("Party.CampOptions is not sorted by morale: %v", options)
+		}
+	}
+}
+
+func TestParty_BestCamp_tooFewHeroes(t *testing.T) {
+	a, _, _, _ := campingTestHeroes()
+	if _, ok := (Party{{Hero: a}}).BestCamp(); ok {
+		t.Error("Party.BestCamp with one hero ok = true, want false")
+	}
+}
+
+func TestBestCampCompanion(t *testing.T) {
+	a, b, c, d := campingTestHeroes()
+	e := &Hero{UUID: "e", Camping: Camping{
+		Topics: []Topic{HeroicTale},
+		Values: CampingValues{Criticism: 100, HeroicTale: 40},
+	}}
+	aCopy := &Hero{UUID: "a", Camping: Camping{Values: CampingValues{Criticism: 1000}}}
+
+	got, result, ok := BestCampCompanion([3]*Hero{a, b, c}, []*Hero{a, aCopy, d, nil, e})
+	if !ok {
+		t.Fatal("BestCampCompanion ok = false, want true")
+	}
+	if got != e {
+		t.Errorf("BestCampCompanion = %v, want e", got.UUID)
+	}
+	// Criticism from a, 10 + 20 - 5 + 100, and HeroicTale from e, 40.
+	want := CampResult{
+		Choices: [2]CampChoice{
+			{Slot: 1, Hero: a, Topic: Criticism},
+			{Slot: 4, Hero: e, Topic: HeroicTale},
+		},
+		Morale: 165,
+	}
+	if diff := cmp.Diff(want, result); diff != "" {
+		t.Errorf("BestCampCompanion mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, _, ok := BestCampCompanion([3]*Hero{a, b, c}, []*Hero{b}); ok {
+		t.Error("BestCampCompanion with no candidate ok = true, want false")
+	}
+}
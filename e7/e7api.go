@@ -11,10 +11,13 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 )
 
 const (
-	defaultBaseURL = "https://api.epicsevendb.com/"
+	defaultBaseURL   = "https://api.epicsevendb.com/"
+	defaultUserAgent = "e7api.go"
 )
 
 // A Client manages communication with the EpicSevenDB API.
@@ -25,33 +28,166 @@ type Client struct {
 	// Base URL for API requests.
 	BaseURL *url.URL
 
+	// User agent used when communicating with the EpicSevenDB API.
+	UserAgent string
+
+	// Default language for localized API responses. An empty Language uses
+	// the API's default, which is English.
+	Language Language
+
+	// First error returned by a ClientOption, reported by NewRequest.
+	optErr error
+
+	// Policy used to retry failed requests.
+	retry RetryPolicy
+
+	// Limiter waited on before every request, if any.
+	limiter Limiter
+
+	// Cache used for conditional requests, if any.
+	cache Cache
+
+	// Timeout set by WithTimeout, applied to the HTTP client once every
+	// option has been applied. Nil if unset.
+	timeout *time.Duration
+
+	// Whether responses are checked for unknown fields, and the callback
+	// they are reported to.
+	strict    bool
+	onUnknown func(req *http.Request, paths []string)
+
+	// Whether HeroesService.GetByID converts display names to hero IDs.
+	normalizeHeroIDs bool
+
+	// Aliases added to the built-in ones used by HeroesService.Find.
+	heroAliases map[string]string
+
+	// Moonlight prefixes added to the built-in ones used by
+	// HeroesService.Find.
+	moonlightPrefixes []string
+
+	// Middleware wrapping Do, outermost first.
+	middleware []Middleware
+
+	rateMu  sync.Mutex
+	rate    Rate // Rate limit state reported by the most recent response.
+	hasRate bool
+
+	searchMu      sync.Mutex
+	searchMissing bool // Whether the API has no search endpoint.
+
 	// Reuse single struct instead of allocating one for each service on the heap.
 	common service
 
 	// Services used for talking to different parts of the EpicSevenDB API.
-	Heroes *HeroesService
+	Heroes    *HeroesService
+	Artifacts *ArtifactsService
+	Items     *ItemsService
+	Effects   *EffectsService
+	Latest    *LatestService
+	Search    *SearchService
 }
 
 type service struct {
 	client *Client
 }
 
-// NewClient returns a new EpicSevenDB API client.
-func NewClient() *Client {
+// A ClientOption configures a Client created by NewClient.
+type ClientOption func(*Client) error
+
+// NewClient returns a new EpicSevenDB API client. Options are applied in
+// order; if an option fails, its error is returned by every subsequent call
+// to NewRequest.
+func NewClient(opts ...ClientOption) *Client {
 	baseURL, _ := url.Parse(defaultBaseURL)
 	c := &Client{
-		client:  &http.Client{},
-		BaseURL: baseURL,
+		client:    &http.Client{},
+		BaseURL:   baseURL,
+		UserAgent: defaultUserAgent,
 	}
 	c.common.client = c
 	c.Heroes = (*HeroesService)(&c.common)
+	c.Artifacts = (*ArtifactsService)(&c.common)
+	c.Items = (*ItemsService)(&c.common)
+	c.Effects = (*EffectsService)(&c.common)
+	c.Latest = (*LatestService)(&c.common)
+	c.Search = (*SearchService)(&c.common)
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil && c.optErr == nil {
+			c.optErr = err
+		}
+	}
+	if c.timeout != nil {
+		c.client.Timeout = *c.timeout
+	}
+	if c.cache != nil {
+		c.client.Transport = &CacheTransport{Cache: c.cache, Transport: c.client.Transport}
+	}
 	return c
 }
 
+// WithHTTPClient sets the HTTP client used to communicate with the API,
+// allowing a custom transport, proxy or timeout to be provided. The client
+// is copied, so later options do not modify httpClient.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("e7: WithHTTPClient requires a non-nil *http.Client")
+		}
+		hc := *httpClient
+		c.client = &hc
+		return nil
+	}
+}
+
+// WithBaseURL sets the base URL for API requests. The URL must be absolute
+// and its path must have a trailing slash.
+func WithBaseURL(rawurl string) ClientOption {
+	return func(c *Client) error {
+		u, err := url.Parse(rawurl)
+		if err != nil {
+			return err
+		}
+		if !u.IsAbs() {
+			return fmt.Errorf("e7: base URL must be absolute, but %q is not", rawurl)
+		}
+		if !strings.HasSuffix(u.Path, "/") {
+			return fmt.Errorf("e7: base URL must have a trailing slash, but %q does not", rawurl)
+		}
+		c.BaseURL = u
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(ua string) ClientOption {
+	return func(c *Client) error {
+		c.UserAgent = ua
+		return nil
+	}
+}
+
+// WithTimeout sets the time limit for requests made by the HTTP client. A
+// timeout of zero means no timeout. It takes precedence over the timeout of
+// a client set by WithHTTPClient, whatever the order of the options.
+func WithTimeout(d time.Duration) ClientOption {
+	return func(c *Client) error {
+		if d < 0 {
+			return fmt.Errorf("e7: timeout must not be negative, got %v", d)
+		}
+		c.timeout = &d
+		return nil
+	}
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the BaseURL of the Client.
 // Relative URLs should always be specified without a preceding slash.
 func (c *Client) NewRequest(method, urlStr string) (*http.Request, error) {
+	if c.optErr != nil {
+		return nil, c.optErr
+	}
 	if !strings.HasSuffix(c.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
 	}
@@ -65,9 +201,43 @@ func (c *Client) NewRequest(method, urlStr string) (*http.Request, error) {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
 	return req, nil
 }
 
+// Response is an EpicSevenDB API response. This wraps the standard
+// http.Response returned from EpicSevenDB and provides convenient access to
+// the metadata and rate limit state reported by the API.
+type Response struct {
+	*http.Response
+
+	// Metadata returned in the body of the response. It is the zero value
+	// if the body could not be decoded.
+	Metadata Metadata
+
+	// Rate limit state reported in the headers of the response, if any.
+	Rate Rate
+
+	// FromCache reports whether the response was served from the client's
+	// cache rather than downloaded.
+	FromCache bool
+
+	// UnknownFields are the JSON paths of response fields that are not
+	// modeled by the decoded type. It is only set when strict decoding is
+	// enabled with WithStrictDecoding.
+	UnknownFields []string
+}
+
+// newResponse creates a new Response for the provided http.Response.
+func newResponse(r *http.Response) *Response {
+	response := &Response{Response: r}
+	response.Rate, _ = parseRate(r, time.Now())
+	response.FromCache = r.Header.Get(XFromCache) != ""
+	return response
+}
+
 // ErrNilContext is returned when a nil context is provided in Do.
 var ErrNilContext error = errors.New("context must be non-nil")
 
@@ -76,13 +246,72 @@ var ErrNilContext error = errors.New("context must be non-nil")
 // error if an API error occurred.
 //
 // The provided ctx must be non-nil, if it is nil an error is returned. If it
-// is canceled or times out, ctx.Err() will be returned.
-func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
+// is canceled or times out, ctx.Err() will be returned. A language stored in
+// ctx by ContextWithLanguage takes precedence over the client's Language.
+//
+// Every attempt first waits on the client's Limiter, if one is set. Failed
+// requests are retried according to the client's RetryPolicy. If more than
+// one attempt was made, the returned error is a *RetryError.
+//
+// Middleware registered with WithMiddleware wraps the whole call, including
+// retries.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
 	if ctx == nil {
 		return nil, ErrNilContext
 	}
-	req = req.WithContext(ctx)
+	req = c.withLanguage(ctx, req.WithContext(ctx))
+
+	do := c.doWithRetry
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		do = c.middleware[i](do)
+	}
+	return do(ctx, req, v)
+}
+
+// doWithRetry sends req, retrying it according to the client's RetryPolicy.
+// See Do.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	for attempt := 1; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				if attempt > 1 {
+					err = &RetryError{Attempts: attempt - 1, Err: err}
+				}
+				return nil, err
+			}
+		}
 
+		resp, err := c.do(ctx, req, v)
+		if err == nil {
+			return resp, nil
+		}
+
+		var httpResp *http.Response
+		if resp != nil {
+			httpResp = resp.Response
+		}
+		delay, ok := c.retry.next(ctx, req, attempt, httpResp, err)
+		if !ok {
+			if attempt > 1 {
+				err = &RetryError{Attempts: attempt, Err: err}
+			}
+			return resp, err
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return resp, &RetryError{Attempts: attempt, Err: err}
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, &RetryError{Attempts: attempt, Err: err}
+			}
+			req.Body = body
+		}
+	}
+}
+
+// do makes a single attempt at sending req. See Do.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
 	resp, err := c.client.Do(req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
@@ -95,6 +324,8 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*htt
 
 		return nil, err
 	}
+	response := newResponse(resp)
+	c.updateRate(resp)
 
 	defer func() {
 		// Ensure the response body is fully read and closed
@@ -113,29 +344,62 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*htt
 
 	err = CheckResponse(resp)
 	if err != nil {
-		return resp, err
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) {
+			response.Metadata = errResp.Metadata
+		}
+		return response, err
 	}
 
 	if v != nil {
 		if w, ok := v.(io.Writer); ok {
-			io.Copy(w, resp.Body)
+			if _, err := io.Copy(w, resp.Body); err != nil {
+				select {
+				case <-ctx.Done():
+					return response, ctx.Err()
+				default:
+				}
+				return response, err
+			}
 		} else {
-			decErr := json.NewDecoder(resp.Body).Decode(v)
+			body := io.Reader(resp.Body)
+			var data []byte
+			if c.strict {
+				if data, err = ioutil.ReadAll(resp.Body); err != nil {
+					return response, err
+				}
+				body = bytes.NewReader(data)
+			}
+
+			decErr := json.NewDecoder(body).Decode(v)
 			if decErr == io.EOF {
 				decErr = nil // ignore EOF errors caused by empty response body
 			}
 			if decErr != nil {
-				err = decErr
+				err = newDecodeError(decErr)
+			} else if c.strict && len(data) > 0 {
+				response.UnknownFields, _ = UnknownFields(data, v)
+				if len(response.UnknownFields) > 0 && c.onUnknown != nil {
+					c.onUnknown(req, response.UnknownFields)
+				}
+			}
+			if m, ok := v.(metadataCarrier); ok {
+				response.Metadata = m.metadata()
 			}
 		}
 	}
 
-	return resp, err
+	return response, err
 }
 
 // CheckResponse checks the API response for errors and returns them if
 // present. A response is considered an error if it has a status code
-// outside the 200 range or equal to 202 accepted.
+// outside the 200 range.
+//
+// A 404 response is reported as a *NotFoundError, a 429 response as a
+// *RateLimitError and a 5xx response as a *ServerError. Any other error
+// status is reported as an *ErrorResponse, which every other error type
+// also wraps.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; 200 <= c && c <= 299 {
 		return nil
@@ -149,7 +413,13 @@ func CheckResponse(r *http.Response) error {
 	}
 	// Re-populate error response body
 	r.Body = ioutil.NopCloser(bytes.NewBuffer(data))
-	switch {
+	switch c := r.StatusCode; {
+	case c == http.StatusNotFound:
+		return &NotFoundError{ErrorResponse: errorResponse}
+	case c == http.StatusTooManyRequests:
+		return newRateLimitError(errorResponse, time.Now())
+	case c >= 500:
+		return &ServerError{ErrorResponse: errorResponse}
 	default:
 		return errorResponse
 	}
@@ -164,16 +434,245 @@ type ErrorResponse struct {
 	Metadata Metadata `json:"meta,omitempty"`
 }
 
-// Metadata is set of metadata that is returned in every EpicSevenDB API rsponse.
-type Metadata struct {
-	RequestDate string `json:"requestDate,omitempty"`
-	APIVersion  string `json:"apiVersion,omitempty"`
-}
-
 func (r *ErrorResponse) Error() string {
+	// Responses synthesized by middleware may lack the HTTP details.
+	if r.Response == nil {
+		return r.Message
+	}
+	if r.Response.Request == nil {
+		return fmt.Sprintf("%d %v", r.Response.StatusCode, r.Message)
+	}
 	return fmt.Sprintf("%v %v: %d %v",
 		r.Response.Request.Method,
 		r.Response.Request.URL,
 		r.Response.StatusCode,
 		r.Message)
 }
+
+// Sentinel errors that the typed API errors match with errors.Is.
+var (
+	// ErrNotFound is matched by every *NotFoundError.
+	ErrNotFound = errors.New("e7: not found")
+
+	// ErrHeroNotFound is matched by a *NotFoundError returned when
+	// fetching a hero that does not exist.
+	ErrHeroNotFound = errors.New("e7: hero not found")
+
+	// ErrArtifactNotFound is matched by a *NotFoundError returned when
+	// fetching an artifact that does not exist.
+	ErrArtifactNotFound = errors.New("e7: artifact not found")
+
+	// ErrItemNotFound is matched by a *NotFoundError returned when
+	// fetching an item that does not exist.
+	ErrItemNotFound = errors.New("e7: item not found")
+
+	// ErrRateLimited is matched by every *RateLimitError.
+	ErrRateLimited = errors.New("e7: rate limited")
+
+	// ErrServerError is matched by every *ServerError.
+	ErrServerError = errors.New("e7: server error")
+)
+
+// notFoundErrors maps resource names to the sentinel error matched by a
+// *NotFoundError for that resource.
+var notFoundErrors = map[string]error{
+	"hero":     ErrHeroNotFound,
+	"artifact": ErrArtifactNotFound,
+	"item":     ErrItemNotFound,
+}
+
+// NotFoundError occurs when the requested resource does not exist.
+type NotFoundError struct {
+	*ErrorResponse
+
+	// Resource is the kind of resource that was not found, e.g. "hero". It
+	// is empty if the error did not come from a service method.
+	Resource string
+
+	// ID is the identifier of the resource that was not found, if known.
+	ID string
+}
+
+func (e *NotFoundError) Error() string {
+	if e.ErrorResponse == nil {
+		return fmt.Sprintf("%v %q not found", e.Resource, e.ID)
+	}
+	if e.Resource == "" {
+		return e.ErrorResponse.Error()
+	}
+	return fmt.Sprintf("%v: %v %q not found", e.ErrorResponse.Error(), e.Resource, e.ID)
+}
+
+// Is reports whether target is ErrNotFound or the sentinel error for the
+// resource that was not found, such as ErrHeroNotFound.
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound || (target != nil && target == notFoundErrors[e.Resource])
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *NotFoundError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// AmbiguousResultError occurs when a request for a single object returns
+// several results, none of which has exactly the requested ID.
+type AmbiguousResultError struct {
+	// Resource is the kind of resource that was requested, e.g. "hero".
+	Resource string
+
+	// ID is the requested identifier.
+	ID string
+
+	// Candidates are the identifiers of the returned results.
+	Candidates []string
+}
+
+func (e *AmbiguousResultError) Error() string {
+	return fmt.Sprintf("e7: %v %q is ambiguous, candidates: %v", e.Resource, e.ID, strings.Join(e.Candidates, ", "))
+}
+
+// Is reports whether target is ErrAmbiguousResult.
+func (e *AmbiguousResultError) Is(target error) bool {
+	return target == ErrAmbiguousResult
+}
+
+// ErrAmbiguousResult is matched by every *AmbiguousResultError.
+var ErrAmbiguousResult = errors.New("e7: ambiguous result")
+
+// pickResult selects the single object requested by id from the n results of
+// resp, whose identifiers are given by idAt, and returns its index. A single
+// result is always selected. Among several results, the one whose identifier
+// is exactly id is selected, otherwise an *AmbiguousResultError is returned.
+// If there are no results, a *NotFoundError is returned. resp may be nil, or
+// lack its *http.Response, if it was synthesized by a middleware.
+//
+// Every service method that fetches a single object should select it with
+// pickResult rather than indexing the results directly.
+func pickResult(resp *Response, resource, id string, n int, idAt func(i int) string) (int, error) {
+	switch n {
+	case 0:
+		errResp := &ErrorResponse{Message: "no results"}
+		if resp != nil {
+			errResp.Response, errResp.Metadata = resp.Response, resp.Metadata
+		}
+		return -1, &NotFoundError{ErrorResponse: errResp, Resource: resource, ID: id}
+	case 1:
+		return 0, nil
+	}
+
+	candidates := make([]string, n)
+	for i := 0; i < n; i++ {
+		candidates[i] = idAt(i)
+		if candidates[i] == id {
+			return i, nil
+		}
+	}
+	return -1, &AmbiguousResultError{Resource: resource, ID: id, Candidates: candidates}
+}
+
+// notFound annotates err with the resource and ID that were requested if it
+// is a *NotFoundError, and returns it.
+func notFound(err error, resource, id string) error {
+	var nf *NotFoundError
+	if errors.As(err, &nf) {
+		nf.Resource, nf.ID = resource, id
+	}
+	return err
+}
+
+// RateLimitError occurs when the API rejects a request because too many
+// requests have been made.
+type RateLimitError struct {
+	*ErrorResponse
+
+	// Rate is the rate limit state reported with the response, if any.
+	Rate Rate
+
+	// Reset is the time after which requests may be made again, taken from
+	// the Retry-After header or the rate limit reset time. It is the zero
+	// time if the server did not report one.
+	Reset time.Time
+}
+
+func newRateLimitError(r *ErrorResponse, now time.Time) *RateLimitError {
+	e := &RateLimitError{ErrorResponse: r}
+	e.Rate, _ = parseRate(r.Response, now)
+	if d, ok := parseRetryAfter(r.Response.Header.Get("Retry-After"), now); ok {
+		e.Reset = now.Add(d)
+	} else {
+		e.Reset = e.Rate.Reset
+	}
+	return e
+}
+
+func (e *RateLimitError) Error() string {
+	if e.Reset.IsZero() {
+		return e.ErrorResponse.Error()
+	}
+	return fmt.Sprintf("%v: rate limited until %v", e.ErrorResponse.Error(), e.Reset)
+}
+
+// Is reports whether target is ErrRateLimited.
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *RateLimitError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// ServerError occurs when the API fails to handle a request because of an
+// internal error.
+type ServerError struct {
+	*ErrorResponse
+}
+
+// Is reports whether target is ErrServerError.
+func (e *ServerError) Is(target error) bool {
+	return target == ErrServerError
+}
+
+// Unwrap returns the underlying *ErrorResponse.
+func (e *ServerError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// DecodeError occurs when a successful API response cannot be decoded.
+type DecodeError struct {
+	// Path is the dot-separated path of the JSON value that could not be
+	// decoded, e.g. "results.skills.buff", if known.
+	Path string
+
+	// Offset is the byte offset in the response body at which decoding
+	// failed, if known.
+	Offset int64
+
+	// Err is the underlying decoding error.
+	Err error
+}
+
+func newDecodeError(err error) *DecodeError {
+	e := &DecodeError{Err: err}
+	var typeErr *json.UnmarshalTypeError
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.As(err, &typeErr):
+		e.Path, e.Offset = typeErr.Field, typeErr.Offset
+	case errors.As(err, &syntaxErr):
+		e.Offset = syntaxErr.Offset
+	}
+	return e
+}
+
+func (e *DecodeError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("e7: decoding response: %v", e.Err)
+	}
+	return fmt.Sprintf("e7: decoding response at %v: %v", e.Path, e.Err)
+}
+
+// Unwrap returns the underlying decoding error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
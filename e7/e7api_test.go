@@ -50,9 +50,7 @@ func setup() (client *Client, mux *http.ServeMux, serverURL string, teardown fun
 	server := httptest.NewServer(apiHandler)
 
 	// client is the EpicSevenDB client being tested and is configured to use test server.
-	client = NewClient()
-	url, _ := url.Parse(server.URL + baseURLPath + "/")
-	client.BaseURL = url
+	client = NewClient(WithBaseURL(server.URL + baseURLPath + "/"))
 
 	return client, mux, server.URL, server.Close
 }
@@ -70,6 +68,69 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+func TestNewClient_options(t *testing.T) {
+	hc := &http.Client{Timeout: time.Second}
+	c := NewClient(
+		WithHTTPClient(hc),
+		WithBaseURL("https://example.com/api/"),
+		WithUserAgent("ua"),
+		WithTimeout(2*time.Second),
+	)
+
+	if got, want := c.BaseURL.String(), "https://example.com/api/"; got != want {
+		t.Errorf("NewClient BaseURL is %v, want %v", got, want)
+	}
+	if got, want := c.UserAgent, "ua"; got != want {
+		t.Errorf("NewClient UserAgent is %v, want %v", got, want)
+	}
+	if c.client == hc {
+		t.Error("WithHTTPClient should copy the provided http.Client")
+	}
+	if got, want := c.client.Timeout, 2*time.Second; got != want {
+		t.Errorf("NewClient Timeout is %v, want %v", got, want)
+	}
+	if got, want := hc.Timeout, time.Second; got != want {
+		t.Errorf("WithTimeout modified provided http.Client Timeout to %v, want %v", got, want)
+	}
+}
+
+func TestNewClient_timeoutBeforeHTTPClient(t *testing.T) {
+	hc := &http.Client{Timeout: time.Second}
+	c := NewClient(WithTimeout(2*time.Second), WithHTTPClient(hc))
+
+	if got, want := c.client.Timeout, 2*time.Second; got != want {
+		t.Errorf("NewClient Timeout is %v, want %v", got, want)
+	}
+	if got, want := hc.Timeout, time.Second; got != want {
+		t.Errorf("WithTimeout modified provided http.Client Timeout to %v, want %v", got, want)
+	}
+
+	// Without WithTimeout, the provided client's timeout is kept.
+	if got, want := NewClient(WithHTTPClient(hc)).client.Timeout, time.Second; got != want {
+		t.Errorf("NewClient Timeout is %v, want %v", got, want)
+	}
+}
+
+func TestNewClient_badOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  ClientOption
+	}{
+		{name: "nil http client", opt: WithHTTPClient(nil)},
+		{name: "no trailing slash", opt: WithBaseURL("https://example.com/api")},
+		{name: "relative", opt: WithBaseURL("api/")},
+		{name: "unparsable", opt: WithBaseURL(":")},
+		{name: "negative timeout", opt: WithTimeout(-time.Second)},
+	}
+
+	for _, tt := range tests {
+		c := NewClient(tt.opt)
+		if _, err := c.NewRequest(http.MethodGet, "hero"); err == nil {
+			t.Errorf("%s: NewRequest err = nil, want error", tt.name)
+		}
+	}
+}
+
 func TestNewRequest(t *testing.T) {
 	c := NewClient()
 
@@ -80,6 +141,11 @@ func TestNewRequest(t *testing.T) {
 	if got, want := req.URL.String(), outURL; got != want {
 		t.Errorf("NewRequest(%q) URL is %v, want %v", inURL, got, want)
 	}
+
+	// test that default user agent is attached to the request
+	if got, want := req.Header.Get("User-Agent"), defaultUserAgent; got != want {
+		t.Errorf("NewRequest() User-Agent is %v, want %v", got, want)
+	}
 }
 
 func TestNewRequest_badURL(t *testing.T) {
@@ -160,6 +226,49 @@ func TestDo(t *testing.T) {
 	}
 }
 
+func TestDo_metadata(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [], "meta": {"requestDate": "Sat Aug 22 00:54:50 UTC 2020", "apiVersion": "2.1.0"}}`)
+	})
+
+	req, _ := client.NewRequest("GET", ".")
+	resp, err := client.Do(context.Background(), req, new(HeroesResponse))
+	if err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	want := Metadata{
+		RequestDate: time.Date(2020, time.August, 22, 0, 54, 50, 0, time.UTC),
+		APIVersion:  Version{Major: 2, Minor: 1, Patch: 0},
+	}
+	if diff := cmp.Diff(want, resp.Metadata); diff != "" {
+		t.Errorf("Response.Metadata mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDo_errorMetadata(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": "m", "meta": {"requestDate": "Sat Aug 22 00:54:50 UTC 2020", "apiVersion": "2.1.0"}}`)
+	})
+
+	req, _ := client.NewRequest("GET", ".")
+	resp, err := client.Do(context.Background(), req, nil)
+	if err == nil {
+		t.Fatal("expected HTTP 400 error, got no error.")
+	}
+
+	if got, want := resp.Metadata.APIVersion, (Version{Major: 2, Minor: 1}); got != want {
+		t.Errorf("Response.Metadata.APIVersion is %v, want %v", got, want)
+	}
+}
+
 func TestDo_nilContext(t *testing.T) {
 	client, _, _, teardown := setup()
 	defer teardown()
@@ -313,8 +422,8 @@ func TestCheckResponse(t *testing.T) {
 		Message:  "Invalid request. Please read the API docs. Open an issue on Github if this keeps happening.",
 		Stack:    "",
 		Metadata: Metadata{
-			RequestDate: "Sat Aug 22 00:54:50 UTC 2020",
-			APIVersion:  "2.1.0",
+			RequestDate: time.Date(2020, time.August, 22, 0, 54, 50, 0, time.UTC),
+			APIVersion:  Version{Major: 2, Minor: 1, Patch: 0},
 		},
 	}
 
@@ -381,6 +490,151 @@ func TestCheckResponse_unexpectedErrorStructure(t *testing.T) {
 	}
 }
 
+func TestCheckResponse_typedErrors(t *testing.T) {
+	tests := []struct {
+		status   int
+		sentinel error
+		check    func(error) bool
+	}{
+		{
+			status:   http.StatusNotFound,
+			sentinel: ErrNotFound,
+			check:    func(err error) bool { var e *NotFoundError; return errors.As(err, &e) },
+		},
+		{
+			status:   http.StatusTooManyRequests,
+			sentinel: ErrRateLimited,
+			check:    func(err error) bool { var e *RateLimitError; return errors.As(err, &e) },
+		},
+		{
+			status:   http.StatusBadGateway,
+			sentinel: ErrServerError,
+			check:    func(err error) bool { var e *ServerError; return errors.As(err, &e) },
+		},
+	}
+
+	for _, tt := range tests {
+		res := &http.Response{
+			Request:    &http.Request{},
+			StatusCode: tt.status,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"error": "m"}`)),
+		}
+		err := CheckResponse(res)
+
+		if !tt.check(err) {
+			t.Errorf("CheckResponse for HTTP %d returned %T", tt.status, err)
+		}
+		if !errors.Is(err, tt.sentinel) {
+			t.Errorf("CheckResponse for HTTP %d does not match %v", tt.status, tt.sentinel)
+		}
+		var errResp *ErrorResponse
+		if !errors.As(err, &errResp) || errResp.Message != "m" {
+			t.Errorf("CheckResponse for HTTP %d does not wrap *ErrorResponse", tt.status)
+		}
+	}
+}
+
+func TestCheckResponse_rateLimitReset(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusTooManyRequests,
+		Header: http.Header{
+			"X-Ratelimit-Limit":     {"60"},
+			"X-Ratelimit-Remaining": {"0"},
+			"X-Ratelimit-Reset":     {"1598057690"},
+		},
+		Body: ioutil.NopCloser(strings.NewReader("")),
+	}
+	err := CheckResponse(res).(*RateLimitError)
+
+	if got, want := err.Reset, time.Unix(1598057690, 0); !got.Equal(want) {
+		t.Errorf("RateLimitError.Reset is %v, want %v", got, want)
+	}
+	if got, want := err.Rate.Limit, 60; got != want {
+		t.Errorf("RateLimitError.Rate.Limit is %v, want %v", got, want)
+	}
+}
+
+func TestNotFoundError_Is(t *testing.T) {
+	err := &NotFoundError{ErrorResponse: &ErrorResponse{Response: &http.Response{Request: &http.Request{}}}}
+	if errors.Is(err, ErrHeroNotFound) {
+		t.Error("NotFoundError without resource matches ErrHeroNotFound")
+	}
+
+	err.Resource, err.ID = "hero", "h"
+	if !errors.Is(err, ErrHeroNotFound) || !errors.Is(err, ErrNotFound) {
+		t.Error("hero NotFoundError does not match ErrHeroNotFound and ErrNotFound")
+	}
+	if !strings.Contains(err.Error(), `hero "h" not found`) {
+		t.Errorf("NotFoundError.Error() = %q, want it to name the hero", err.Error())
+	}
+}
+
+func TestPickResult(t *testing.T) {
+	resp := &Response{Response: &http.Response{Request: &http.Request{}}}
+	ids := []string{"a", "b", "c"}
+	idAt := func(i int) string { return ids[i] }
+
+	tests := []struct {
+		id      string
+		n       int
+		want    int
+		wantErr error
+	}{
+		{id: "b", n: 0, want: -1, wantErr: ErrNotFound},
+		{id: "z", n: 1, want: 0},
+		{id: "b", n: 3, want: 1},
+		{id: "z", n: 3, want: -1, wantErr: ErrAmbiguousResult},
+	}
+
+	for _, tt := range tests {
+		got, err := pickResult(resp, "thing", tt.id, tt.n, idAt)
+		if got != tt.want {
+			t.Errorf("pickResult(%q, %d) = %d, want %d", tt.id, tt.n, got, tt.want)
+		}
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("pickResult(%q, %d) err = %v, want %v", tt.id, tt.n, err, tt.wantErr)
+		}
+	}
+}
+
+func TestPickResult_syntheticResponse(t *testing.T) {
+	for _, resp := range []*Response{nil, {}, {Response: &http.Response{StatusCode: http.StatusOK}}} {
+		_, err := pickResult(resp, "thing", "a", 0, nil)
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("pickResult(%#v) err = %v, want ErrNotFound", resp, err)
+			continue
+		}
+		if msg := err.Error(); !strings.Contains(msg, `thing "a" not found`) {
+			t.Errorf("pickResult(%#v) err.Error() = %q, want it to name the thing", resp, msg)
+		}
+	}
+}
+
+func TestDo_decodeError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [{"rarity": "five"}]}`)
+	})
+
+	req, _ := client.NewRequest("GET", ".")
+	_, err := client.Do(context.Background(), req, new(HeroesResponse))
+
+	var decErr *DecodeError
+	if !errors.As(err, &decErr) {
+		t.Fatalf("expected *DecodeError, got: %#v", err)
+	}
+	if !strings.HasSuffix(decErr.Path, "rarity") {
+		t.Errorf("DecodeError.Path is %q, want it to end with %q", decErr.Path, "rarity")
+	}
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Errorf("expected DecodeError to wrap *json.UnmarshalTypeError, got: %#v", decErr.Err)
+	}
+}
+
 func TestErrorResponse_Error(t *testing.T) {
 	res := &http.Response{Request: &http.Request{}}
 	err := ErrorResponse{Message: "m", Response: res}
@@ -0,0 +1,145 @@
+package e7
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// EffectsService handles communication with the buff, debuff and common
+// effect related methods of the EpicSevenDB API.
+type EffectsService service
+
+// Effect types, as found in Common.Type.
+const (
+	EffectTypeBuff   = "buff"
+	EffectTypeDebuff = "debuff"
+	EffectTypeCommon = "common"
+)
+
+// EffectsResponse is an EpicSevenDB API response that contains a list
+// of effects.
+type EffectsResponse struct {
+	Results  []Common `json:"results,omitempty"`
+	Metadata Metadata `json:"meta,omitempty"`
+}
+
+func (r *EffectsResponse) metadata() Metadata {
+	return r.Metadata
+}
+
+// List fetches all buff, debuff and common effects. The kind of each effect
+// is given by its Type.
+func (s *EffectsService) List(ctx context.Context) ([]Common, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "effect")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(EffectsResponse)
+	resp, err := s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Results, resp, nil
+}
+
+// Resolver fetches all effects and returns an EffectResolver for them.
+func (s *EffectsService) Resolver(ctx context.Context) (*EffectResolver, *Response, error) {
+	effects, resp, err := s.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	return NewEffectResolver(effects), resp, nil
+}
+
+// EffectResolver resolves the effect IDs of a skill to the effects
+// themselves. It is safe for concurrent use.
+type EffectResolver struct {
+	buffs   map[uint]Buff
+	debuffs map[uint]Debuff
+	common  map[uint]Common
+}
+
+// NewEffectResolver returns an EffectResolver for the given effects, as
+// returned by EffectsService.List. Effects are classified by their Type;
+// effects of an unknown type are treated as common effects.
+func NewEffectResolver(effects []Common) *EffectResolver {
+	r := &EffectResolver{
+		buffs:   make(map[uint]Buff),
+		debuffs: make(map[uint]Debuff),
+		common:  make(map[uint]Common),
+	}
+	for _, e := range effects {
+		switch strings.ToLower(e.Type) {
+		case EffectTypeBuff:
+			r.buffs[e.ID] = Buff{Common: e}
+		case EffectTypeDebuff:
+			r.debuffs[e.ID] = Debuff{Common: e}
+		default:
+			r.common[e.ID] = e
+		}
+	}
+	return r
+}
+
+// EffectResolver returns an EffectResolver for the effects embedded in the
+// hero's payload.
+func (h *Hero) EffectResolver() *EffectResolver {
+	r := &EffectResolver{
+		buffs:   make(map[uint]Buff, len(h.Buffs)),
+		debuffs: make(map[uint]Debuff, len(h.Debuffs)),
+		common:  make(map[uint]Common, len(h.Common)),
+	}
+	for _, b := range h.Buffs {
+		r.buffs[b.ID] = b
+	}
+	for _, d := range h.Debuffs {
+		r.debuffs[d.ID] = d
+	}
+	for _, c := range h.Common {
+		r.common[c.ID] = c
+	}
+	return r
+}
+
+// SkillEffects are the effects applied by a skill.
+type SkillEffects struct {
+	Buffs   []Buff
+	Debuffs []Debuff
+	Common  []Common
+
+	// Missing holds the IDs that could not be resolved, in the order the
+	// skill references them: buffs first, then debuffs, then common
+	// effects.
+	Missing []uint
+}
+
+// Resolve returns the effects referenced by the skill's Buff, Debuff and
+// Common IDs, in the order they are referenced.
+func (r *EffectResolver) Resolve(s Skill) SkillEffects {
+	var effects SkillEffects
+	for _, id := range s.Buff {
+		if b, ok := r.buffs[id]; ok {
+			effects.Buffs = append(effects.Buffs, b)
+		} else {
+			effects.Missing = append(effects.Missing, id)
+		}
+	}
+	for _, id := range s.Debuff {
+		if d, ok := r.debuffs[id]; ok {
+			effects.Debuffs = append(effects.Debuffs, d)
+		} else {
+			effects.Missing = append(effects.Missing, id)
+		}
+	}
+	for _, id := range s.Common {
+		if c, ok := r.common[id]; ok {
+			effects.Common = append(effects.Common, c)
+		} else {
+			effects.Missing = append(effects.Missing, id)
+		}
+	}
+	return effects
+}
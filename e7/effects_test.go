@@ -0,0 +1,120 @@
+package e7
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHero_typedEffects(t *testing.T) {
+	data := []byte(`{
+		"_id": "h",
+		"skills": [{"buff": [1], "debuff": [2, 9], "common": [3]}],
+		"buffs": [{"_id": "b", "id": 1, "type": "buff", "name": "Increase Attack", "effect": "e", "assets": {"icon": "bi"}}],
+		"debuffs": [{"_id": "d", "id": 2, "type": "debuff", "name": "Stun"}],
+		"common": [{"_id": "c", "id": 3, "type": "common", "name": "Extra Attack"}]
+	}`)
+
+	h := new(Hero)
+	if err := json.Unmarshal(data, h); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	wantBuffs := []Buff{{Common{UUID: "b", ID: 1, Type: "buff", Name: "Increase Attack", Effect: "e", Assets: Assets{Icon: "bi"}}}}
+	if diff := cmp.Diff(wantBuffs, h.Buffs); diff != "" {
+		t.Errorf("Hero.Buffs mismatch (-want +got):\n%s", diff)
+	}
+
+	got := h.EffectResolver().Resolve(h.Skills[0])
+	want := SkillEffects{
+		Buffs:   wantBuffs,
+		Debuffs: []Debuff{{Common{UUID: "d", ID: 2, Type: "debuff", Name: "Stun"}}},
+		Common:  []Common{{UUID: "c", ID: 3, Type: "common", Name: "Extra Attack"}},
+		Missing: []uint{9},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("EffectResolver.Resolve mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestEffectsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/effect", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+		{
+			"results": [
+				{"id": 1, "type": "buff", "name": "B"},
+				{"id": 1, "type": "debuff", "name": "D"}
+			],
+			"meta": {
+				"requestDate": "date",
+				"apiVersion": "1"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Effects.List(ctx)
+	if err != nil {
+		t.Errorf("Effects.List returned error: %v", err)
+	}
+
+	want := []Common{
+		{ID: 1, Type: "buff", Name: "B"},
+		{ID: 1, Type: "debuff", Name: "D"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Effects.List mismatch (-want +got):\n%s", diff)
+	}
+
+	// Test s.client.NewRequest failure
+	client.BaseURL.Path = ""
+	got, resp, err := client.Effects.List(ctx)
+	if got != nil {
+		t.Errorf("client.BaseURL.Path='' List = %#v, want nil", got)
+	}
+	if resp != nil {
+		t.Errorf("client.BaseURL.Path='' List resp = %#v, want nil", resp)
+	}
+	if err == nil {
+		t.Error("client.BaseURL.Path='' List err = nil, want error")
+	}
+}
+
+func TestEffectsService_Resolver(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/effect", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `
+		{
+			"results": [
+				{"id": 1, "type": "buff", "name": "B"},
+				{"id": 1, "type": "debuff", "name": "D"},
+				{"id": 2, "type": "common", "name": "C"}
+			]
+		}`)
+	})
+
+	r, _, err := client.Effects.Resolver(context.Background())
+	if err != nil {
+		t.Fatalf("Effects.Resolver returned error: %v", err)
+	}
+
+	got := r.Resolve(Skill{Buff: []uint{1}, Debuff: []uint{1}, Common: []uint{2}})
+	want := SkillEffects{
+		Buffs:   []Buff{{Common{ID: 1, Type: "buff", Name: "B"}}},
+		Debuffs: []Debuff{{Common{ID: 1, Type: "debuff", Name: "D"}}},
+		Common:  []Common{{ID: 2, Type: "common", Name: "C"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("EffectResolver.Resolve mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,142 @@
+package e7
+
+import "errors"
+
+// ErrInvalidSkillLevel is returned by Hero.SkillEnhancementCost when a skill
+// enhancement level is out of range.
+var ErrInvalidSkillLevel = errors.New("invalid skill enhancement level")
+
+// Identifiers of the items that Hero.SkillEnhancementCost totals
+// separately. Items are matched by identifier because, unlike their names,
+// identifiers do not depend on the response language.
+const (
+	molagoraItem   = "ma_mola"
+	molagoragoItem = "ma_mola2"
+	stigmaItem     = "to_stigma"
+	goldItem       = "to_gold"
+)
+
+// SkillLevels are the enhancement levels of a hero's skills, indexed like
+// Hero.Skills. Level 0 is an unenhanced skill, and level n is reached by
+// applying the first n of the skill's Enhancements. Missing levels are 0.
+type SkillLevels []int
+
+func (l SkillLevels) at(i int) int {
+	if i < len(l) {
+		return l[i]
+	}
+	return 0
+}
+
+// SkillEnhancementCost represents the materials needed to enhance a hero's
+// skills.
+type SkillEnhancementCost struct {
+	// Materials are the total costs of every item, in the order the items
+	// are first needed.
+	Materials []MaterialCost
+
+	// Molagoras, Molagoragos, Catalysts, Stigma and Gold are the total
+	// counts of those materials in Materials.
+	Molagoras   int
+	Molagoragos int
+	Catalysts   int
+	Stigma      int
+	Gold        int
+
+	// Skills are the costs of every skill that is enhanced, in skill
+	// order.
+	Skills []SkillCost
+}
+
+// SkillCost represents the materials needed to enhance one skill.
+type SkillCost struct {
+	// Skill is the index of the skill in Hero.Skills.
+	Skill int
+	Name  string
+
+	// From and To are the enhancement levels of the skill.
+	From, To int
+
+	// Materials are the total costs of every item for this skill.
+	Materials []MaterialCost
+
+	// Unlocked are the descriptions of the enhancements applied, such as
+	// "+5% damage dealt", in order.
+	Unlocked []string
+}
+
+// SkillEnhancementCost returns the materials needed to enhance the skills of
+// h from the levels current to the levels target. A nil current means that
+// no skill is enhanced.
+//
+// Molagoras, molagoragos, stigma and gold are identified by their item
+// identifier, so the totals are the same in every response language, and
+// catalysts by their category.
+//
+// If a level is negative, above the number of enhancements of its skill, or
+// a target level is below the current one, or there are more levels than
+// skills, the returned error is ErrInvalidSkillLevel.
+func (h *Hero) SkillEnhancementCost(current, target SkillLevels) (*SkillEnhancementCost, error) {
+	if len(current) > len(h.Skills) || len(target) > len(h.Skills) {
+		return nil, ErrInvalidSkillLevel
+	}
+
+	var total materialTotals
+	cost := new(SkillEnhancementCost)
+	for i, skill := range h.Skills {
+		from, to := current.at(i), target.at(i)
+		if from < 0 || from > to || to > len(skill.Enhancements) {
+			return nil, ErrInvalidSkillLevel
+		}
+		if from == to {
+			continue
+		}
+
+		var materials materialTotals
+		sc := SkillCost{Skill: i, Name: skill.Name, From: from, To: to}
+		for _, e := range skill.Enhancements[from:to] {
+			for _, c := range e.Costs {
+				m := enhancementMaterial(c)
+				materials.add(m)
+				total.add(m)
+			}
+			sc.Unlocked = append(sc.Unlocked, e.Description)
+		}
+		sc.Materials = materials.costs
+		cost.Skills = append(cost.Skills, sc)
+	}
+
+	cost.Materials = total.costs
+	for _, m := range cost.Materials {
+		switch {
+		case m.Identifier == molagoraItem:
+			cost.Molagoras += m.Count
+		case m.Identifier == molagoragoItem:
+			cost.Molagoragos += m.Count
+		case m.Identifier == stigmaItem:
+			cost.Stigma += m.Count
+		case m.Identifier == goldItem:
+			cost.Gold += m.Count
+		case m.Category == Catalyst:
+			cost.Catalysts += m.Count
+		}
+	}
+	return cost, nil
+}
+
+// enhancementMaterial returns the material cost of c.
+func enhancementMaterial(c EnhancementCost) MaterialCost {
+	m := MaterialCost{
+		Identifier: c.Identifier,
+		Name:       c.Name,
+		Category:   ItemCategory(c.Category),
+		Grade:      c.Grade,
+		Count:      int(c.Count),
+	}
+	if s, ok := c.Attribute.(string); ok {
+		if a, ok := attributes[s]; ok {
+			m.Attribute = &a
+		}
+	}
+	return m
+}
@@ -0,0 +1,144 @@
+package e7
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// enhancementTestHero returns a hero decoded from a hero payload in Japanese,
+// so that item names cannot be used to identify materials.
+func enhancementTestHero(t *testing.T) *Hero {
+	t.Helper()
+	h := new(Hero)
+	err := json.Unmarshal([]byte(`{
+		"skills": [
+			{"name": "S1", "enhancements": [
+				{"string": "+5% damage dealt", "costs": [
+					{"item": "to_gold", "identifier": "to_gold", "name": "ゴールド", "category": "currency", "count": 10000},
+					{"item": "ma_mola", "identifier": "ma_mola", "name": "モラゴラ", "category": "material", "grade": 3, "count": 1}
+				]},
+				{"string": "+10% damage dealt", "costs": [
+					{"item": "to_gold", "identifier": "to_gold", "name": "ゴールド", "category": "currency", "count": 10000},
+					{"item": "ma_mola", "identifier": "ma_mola", "name": "モラゴラ", "category": "material", "grade": 3, "count": 2},
+					{"item": "blazing-rage", "identifier": "blazing-rage", "name": "燃え上がる怒り", "category": "catalyst", "attribute": "fire", "grade": 3, "count": 2}
+				]}
+			]},
+			{"name": "S2", "enhancements": [
+				{"string": "-1 turn cooldown", "costs": [
+					{"item": "to_gold", "identifier": "to_gold", "name": "ゴールド", "category": "currency", "count": 10000},
+					{"item": "ma_mola", "identifier": "ma_mola", "name": "モラゴラ", "category": "material", "grade": 3, "count": 2},
+					{"item": "to_stigma", "identifier": "to_stigma", "name": "聖痕", "category": "currency", "count": 100}
+				]}
+			]},
+			{"name": "S3", "enhancements": [
+				{"string": "+5% damage dealt", "costs": [
+					{"item": "to_gold", "identifier": "to_gold", "name": "ゴールド", "category": "currency", "count": 10000},
+					{"item": "ma_mola", "identifier": "ma_mola", "name": "モラゴラ", "category": "material", "grade": 3, "count": 2}
+				]},
+				{"string": "+15% damage dealt", "costs": [
+					{"item": "to_gold", "identifier": "to_gold", "name": "ゴールド", "category": "currency", "count": 10000},
+					{"item": "ma_mola2", "identifier": "ma_mola2", "name": "モラゴラゴ", "category": "material", "grade": 5, "count": 1},
+					{"item": "to_stigma", "identifier": "to_stigma", "name": "聖痕", "category": "currency", "count": 100},
+					{"item": "blazing-rage", "identifier": "blazing-rage", "name": "燃え上がる怒り", "category": "catalyst", "attribute": "fire", "grade": 3, "count": 2}
+				]}
+			]}
+		]
+	}`), h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestHero_SkillEnhancementCost(t *testing.T) {
+	h := enhancementTestHero(t)
+	fire := Fire
+
+	got, err := h.SkillEnhancementCost(SkillLevels{1, 0, 1}, SkillLevels{2, 1, 2})
+	if err != nil {
+		t.Fatalf("Hero.SkillEnhancementCost returned error: %v", err)
+	}
+
+	gold := func(n int) MaterialCost {
+		return MaterialCost{Identifier: goldItem, Name: "ゴールド", Category: Currency, Count: n}
+	}
+	molagora := func(n int) MaterialCost {
+		return MaterialCost{Identifier: molagoraItem, Name: "モラゴラ", Category: Material, Grade: 3, Count: n}
+	}
+	stigma := func(n int) MaterialCost {
+		return MaterialCost{Identifier: stigmaItem, Name: "聖痕", Category: Currency, Count: n}
+	}
+	catalyst := func(n int) MaterialCost {
+		return MaterialCost{Identifier: "blazing-rage", Name: "燃え上がる怒り", Category: Catalyst, Attribute: &fire, Grade: 3, Count: n}
+	}
+	molagorago := MaterialCost{Identifier: molagoragoItem, Name: "モラゴラゴ", Category: Material, Grade: 5, Count: 1}
+
+	want := &SkillEnhancementCost{
+		Materials:   []MaterialCost{gold(30000), molagora(4), catalyst(4), stigma(200), molagorago},
+		Molagoras:   4,
+		Molagoragos: 1,
+		Catalysts:   4,
+		Stigma:      200,
+		Gold:        30000,
+		Skills: []SkillCost{
+			{
+				Skill: 0, Name: "S1", From: 1, To: 2,
+				Materials: []MaterialCost{gold(10000), molagora(2), catalyst(2)},
+				Unlocked:  []string{"+10% damage dealt"},
+			},
+			{
+				Skill: 1, Name: "S2", From: 0, To: 1,
+				Materials: []MaterialCost{gold(10000), molagora(2), stigma(100)},
+				Unlocked:  []string{"-1 turn cooldown"},
+			},
+			{
+				Skill: 2, Name: "S3", From: 1, To: 2,
+				Materials: []MaterialCost{gold(10000), molagorago, stigma(100), catalyst(2)},
+				Unlocked:  []string{"+15% damage dealt"},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Hero.SkillEnhancementCost mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestHero_SkillEnhancementCost_fromScratch(t *testing.T) {
+	h := enhancementTestHero(t)
+
+	got, err := h.SkillEnhancementCost(nil, SkillLevels{2})
+	if err != nil {
+		t.Fatalf("Hero.SkillEnhancementCost returned error: %v", err)
+	}
+	if len(got.Skills) != 1 || got.Skills[0].Skill != 0 {
+		t.Fatalf("Hero.SkillEnhancementCost skills = %+v, want only skill 0", got.Skills)
+	}
+	if diff := cmp.Diff([]string{"+5% damage dealt", "+10% damage dealt"}, got.Skills[0].Unlocked); diff != "" {
+		t.Errorf("Hero.SkillEnhancementCost unlocked mismatch (-want +got):\n%s", diff)
+	}
+	if got.Molagoras != 3 || got.Gold != 20000 || got.Catalysts != 2 || got.Stigma != 0 {
+		t.Errorf("Hero.SkillEnhancementCost totals = %+v", got)
+	}
+}
+
+func TestHero_SkillEnhancementCost_invalidLevels(t *testing.T) {
+	h := enhancementTestHero(t)
+
+	tests := []struct {
+		current, target SkillLevels
+	}{
+		{current: SkillLevels{-1}, target: SkillLevels{1}},
+		{current: SkillLevels{2}, target: SkillLevels{1}},
+		{current: nil, target: SkillLevels{3}},
+		{current: nil, target: SkillLevels{0, 0, 0, 0}},
+		{current: SkillLevels{0, 0, 0, 0}, target: nil},
+	}
+	for _, tt := range tests {
+		if _, err := h.SkillEnhancementCost(tt.current, tt.target); !errors.Is(err, ErrInvalidSkillLevel) {
+			t.Errorf("Hero.SkillEnhancementCost(%v, %v) err = %v, want ErrInvalidSkillLevel", tt.current, tt.target, err)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package e7
+
+import (
+	"errors"
+	"math"
+)
+
+// GearSet represents a gear set whose completion grants stats.
+type GearSet int
+
+// Gear set.
+const (
+	AttackSet GearSet = iota
+	DefenseSet
+	HealthSet
+	SpeedSet
+	CriticalSet
+	DestructionSet
+	HitSet
+	ResistSet
+	UnitySet
+	TorrentSet
+)
+
+var gearSetStrings = map[GearSet]string{
+	AttackSet:      "set_att",
+	DefenseSet:     "set_def",
+	HealthSet:      "set_max_hp",
+	SpeedSet:       "set_speed",
+	CriticalSet:    "set_cri",
+	DestructionSet: "set_cri_dmg",
+	HitSet:         "set_acc",
+	ResistSet:      "set_res",
+	UnitySet:       "set_coop",
+	TorrentSet:     "set_torrent",
+}
+
+func (s GearSet) String() string {
+	return gearSetStrings[s]
+}
+
+// speedPercent is a percentage of base speed. Only the speed set grants it,
+// so it is not exported as a Stat.
+const speedPercent Stat = -1
+
+// gearSetBonuses are the stats granted by each completed gear set.
+var gearSetBonuses = map[GearSet][]StatBonus{
+	AttackSet:      {{Stat: AttackPercent, Value: 0.35}},
+	DefenseSet:     {{Stat: DefensePercent, Value: 0.15}},
+	HealthSet:      {{Stat: HealthPercent, Value: 0.15}},
+	SpeedSet:       {{Stat: speedPercent, Value: 0.25}},
+	CriticalSet:    {{Stat: CriticalHitChance, Value: 0.12}},
+	DestructionSet: {{Stat: CriticalHitDamage, Value: 0.4}},
+	HitSet:         {{Stat: Effectiveness, Value: 0.2}},
+	ResistSet:      {{Stat: EffectResistance, Value: 0.2}},
+	UnitySet:       {{Stat: DualAttackChance, Value: 0.04}},
+	TorrentSet:     {{Stat: AttackPercent, Value: 0.1}, {Stat: HealthPercent, Value: -0.1}},
+}
+
+// StatBonus represents a stat granted by gear or another source. Values of
+// percentage stats, such as AttackPercent or CriticalHitChance, are
+// fractions, e.g. 0.15 for 15%.
+type StatBonus struct {
+	Stat  Stat
+	Value float64
+}
+
+// Build represents a hero's equipment and progression, from which
+// Hero.FinalStats calculates the hero's final stats.
+type Build struct {
+	// State is the precalculated state whose stats are used as the base.
+	State PreCalculatedState
+
+	// Gear are the main and sub stats of the equipped gear.
+	Gear []StatBonus
+
+	// Sets are the completed gear sets. A set completed twice, such as
+	// two health sets, must be listed twice.
+	Sets []GearSet
+
+	// Artifact is the equipped artifact, if any, enhanced to
+	// ArtifactLevel.
+	Artifact      *Artifact
+	ArtifactLevel int
+
+	// SelfDevotion is the hero's self-devotion grade, if any.
+	SelfDevotion DevotionGrade
+
+	// ExclusiveEquipment is the equipped exclusive equipment, if any.
+	ExclusiveEquipment *ExclusiveEquipment
+
+	// Devotion are the stats granted by the devotion of the party's
+	// heroes, as computed by Party.Devotion.
+	Devotion []StatBonus
+}
+
+// StatSource identifies where stats of a final stat calculation come from.
+type StatSource string
+
+// Stat source.
+const (
+	SourceBase               StatSource = "base"
+	SourceGear               StatSource = "gear"
+	SourceSets               StatSource = "sets"
+	SourceArtifact           StatSource = "artifact"
+	SourceSelfDevotion       StatSource = "self_devotion"
+	SourceExclusiveEquipment StatSource = "exclusive_equipment"
+	SourceDevotion           StatSource = "devotion"
+)
+
+// StatValues holds unrounded stat values. Percentage stats are fractions.
+type StatValues struct {
+	Attack            float64
+	Health            float64
+	Speed             float64
+	Defense           float64
+	CriticalHitChance float64
+	CriticalHitDamage float64
+	DualAttackChance  float64
+	Effectiveness     float64
+	EffectResistance  float64
+}
+
+func (v *StatValues) add(o StatValues) {
+	v.Attack += o.Attack
+	v.Health += o.Health
+	v.Speed += o.Speed
+	v.Defense += o.Defense
+	v.CriticalHitChance += o.CriticalHitChance
+	v.CriticalHitDamage += o.CriticalHitDamage
+	v.DualAttackChance += o.DualAttackChance
+	v.Effectiveness += o.Effectiveness
+	v.EffectResistance += o.EffectResistance
+}
+
+// apply adds bonus to v. Percentage bonuses of attack, health, defense and
+// speed are taken of base.
+func (v *StatValues) apply(base CalculatedStat, bonus StatBonus) {
+	switch bonus.Stat {
+	case Attack:
+		v.Attack += bonus.Value
+	case AttackPercent:
+		v.Attack += float64(base.Attack) * bonus.Value
+	case Defense:
+		v.Defense += bonus.Value
+	case DefensePercent:
+		v.Defense += float64(base.Defense) * bonus.Value
+	case Health:
+		v.Health += bonus.Value
+	case HealthPercent:
+		v.Health += float64(base.Health) * bonus.Value
+	case Speed:
+		v.Speed += bonus.Value
+	case speedPercent:
+		v.Speed += float64(base.Speed) * bonus.Value
+	case CriticalHitChance:
+		v.CriticalHitChance += bonus.Value
+	case CriticalHitDamage:
+		v.CriticalHitDamage += bonus.Value
+	case Effectiveness:
+		v.Effectiveness += bonus.Value
+	case EffectResistance:
+		v.EffectResistance += bonus.Value
+	case DualAttackChance:
+		v.DualAttackChance += bonus.Value
+	}
+}
+
+// StatContribution is the stats contributed by one source to a final stat
+// calculation.
+type StatContribution struct {
+	Source StatSource
+	Stats  StatValues
+}
+
+// FinalStats represents the result of a final stat calculation.
+type FinalStats struct {
+	// Total is the final stats. CombatPoints is not calculated.
+	Total CalculatedStat
+
+	// Breakdown lists the contribution of every source, starting with
+	// SourceBase. Sources that contribute nothing are omitted.
+	Breakdown []StatContribution
+}
+
+// ErrNoCalculatedStats is returned by Hero.FinalStats when the hero has no
+// precalculated stats for the requested state.
+var ErrNoCalculatedStats = errors.New("no precalculated stats for state")
+
+// ErrUnknownDevotionGrade is returned by Hero.FinalStats and Party.Devotion
+// when a devotion grade is not a known grade.
+var ErrUnknownDevotionGrade = errors.New("unknown devotion grade")
+
+// FinalStats calculates the final stats of h equipped with b.
+//
+// As in the game, percentage bonuses to attack, health, defense and speed
+// are taken of the base stats of b.State, not of the stats granted by other
+// sources. Attack, health, speed and defense are summed unrounded and
+// rounded down once at the end; percentage stats are not rounded.
+func (h *Hero) FinalStats(b Build) (*FinalStats, error) {
+	base, ok := h.CalculatedStats[b.State]
+	if !ok {
+		return nil, ErrNoCalculatedStats
+	}
+
+	var sources []StatContribution
+	add := func(src StatSource, bonuses ...StatBonus) {
+		var v StatValues
+		for _, bonus := range bonuses {
+			v.apply(base, bonus)
+		}
+		if v != (StatValues{}) {
+			sources = append(sources, StatContribution{Source: src, Stats: v})
+		}
+	}
+
+	sources = append(sources, StatContribution{
+		Source: SourceBase,
+		Stats: StatValues{
+			Attack:            float64(base.Attack),
+			Health:            float64(base.Health),
+			Speed:             float64(base.Speed),
+			Defense:           float64(base.Defense),
+			CriticalHitChance: float64(base.CriticalHitChance),
+			CriticalHitDamage: float64(base.CriticalHitDamage),
+			DualAttackChance:  float64(base.DualAttackChance),
+			Effectiveness:     float64(base.Effectiveness),
+			EffectResistance:  float64(base.EffectResistance),
+		},
+	})
+
+	add(SourceGear, b.Gear...)
+
+	var sets []StatBonus
+	for _, set := range b.Sets {
+		sets = append(sets, gearSetBonuses[set]...)
+	}
+	add(SourceSets, sets...)
+
+	if b.Artifact != nil {
+		stat := b.Artifact.Stats.AtLevel(b.ArtifactLevel)
+		add(SourceArtifact,
+			StatBonus{Stat: Attack, Value: float64(stat.Attack)},
+			StatBonus{Stat: Health, Value: float64(stat.Health)},
+		)
+	}
+
+	if b.SelfDevotion != "" {
+		value, ok := h.SelfDevotion.Grades.Value(b.SelfDevotion)
+		if !ok {
+			return nil, ErrUnknownDevotionGrade
+		}
+		add(SourceSelfDevotion, StatBonus{Stat: h.SelfDevotion.Type, Value: float64(value)})
+	}
+
+	if ee := b.ExclusiveEquipment; ee != nil {
+		add(SourceExclusiveEquipment, StatBonus{Stat: ee.Stat.Type, Value: float64(ee.Stat.Value)})
+	}
+
+	add(SourceDevotion, b.Devotion...)
+
+	var total StatValues
+	for _, src := range sources {
+		total.add(src.Stats)
+	}
+	return &FinalStats{Total: total.round(), Breakdown: sources}, nil
+}
+
+// round converts v to a CalculatedStat, rounding attack, health, speed and
+// defense down.
+func (v StatValues) round() CalculatedStat {
+	floor := func(f float64) uint {
+		// Tolerate float error, e.g. 1000 * 1.15 being 1149.9999999999998.
+		f = math.Floor(f + 1e-9)
+		if f < 0 {
+			return 0
+		}
+		return uint(f)
+	}
+	return CalculatedStat{
+		Attack:            floor(v.Attack),
+		Health:            floor(v.Health),
+		Speed:             floor(v.Speed),
+		Defense:           floor(v.Defense),
+		CriticalHitChance: float32(v.CriticalHitChance),
+		CriticalHitDamage: float32(v.CriticalHitDamage),
+		DualAttackChance:  float32(v.DualAttackChance),
+		Effectiveness:     float32(v.Effectiveness),
+		EffectResistance:  float32(v.EffectResistance),
+	}
+}
@@ -0,0 +1,125 @@
+package e7
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func finalStatsTestHero() *Hero {
+	return &Hero{
+		SelfDevotion: SelfDevotion{
+			Type:   AttackPercent,
+			Grades: DevotionGrades{B: 0.036, A: 0.054, S: 0.072, SS: 0.09, SSS: 0.108},
+		},
+		CalculatedStats: map[PreCalculatedState]CalculatedStat{
+			Level60SixStarFullyAwakened: {
+				CombatPoints:      20000,
+				Attack:            1000,
+				Health:            6000,
+				Speed:             110,
+				Defense:           600,
+				CriticalHitChance: 0.15,
+				CriticalHitDamage: 1.5,
+				DualAttackChance:  0.05,
+			},
+		},
+	}
+}
+
+func TestHero_FinalStats(t *testing.T) {
+	h := finalStatsTestHero()
+	b := Build{
+		State: Level60SixStarFullyAwakened,
+		Gear: []StatBonus{
+			{Stat: Attack, Value: 100},
+			{Stat: AttackPercent, Value: 0.5},
+			{Stat: Health, Value: 500},
+			{Stat: HealthPercent, Value: 0.15},
+			{Stat: Speed, Value: 45},
+			{Stat: CriticalHitChance, Value: 0.3},
+			{Stat: Effectiveness, Value: 0.25},
+		},
+		Sets:     []GearSet{SpeedSet, CriticalSet},
+		Artifact: &Artifact{Stats: ArtifactStats{Base: ArtifactStat{Attack: 42, Health: 63}, Max: ArtifactStat{Attack: 57, Health: 84}}},
+		// Level 30 gives the max artifact stats.
+		ArtifactLevel:      MaxArtifactLevel,
+		SelfDevotion:       GradeSS,
+		ExclusiveEquipment: &ExclusiveEquipment{Stat: ExclusiveEquipmentStat{Type: Speed, Value: 8}},
+	}
+
+	got, err := h.FinalStats(b)
+	if err != nil {
+		t.Fatalf("Hero.FinalStats returned error: %v", err)
+	}
+
+	wantBreakdown := []StatContribution{
+		{Source: SourceBase, Stats: StatValues{Attack: 1000, Health: 6000, Speed: 110, Defense: 600, CriticalHitChance: float64(float32(0.15)), CriticalHitDamage: 1.5, DualAttackChance: float64(float32(0.05))}},
+		{Source: SourceGear, Stats: StatValues{Attack: 600, Health: 1400, Speed: 45, CriticalHitChance: 0.3, Effectiveness: 0.25}},
+		{Source: SourceSets, Stats: StatValues{Speed: 27.5, CriticalHitChance: 0.12}},
+		{Source: SourceArtifact, Stats: StatValues{Attack: 57, Health: 84}},
+		{Source: SourceSelfDevotion, Stats: StatValues{Attack: 1000 * float64(float32(0.09))}},
+		{Source: SourceExclusiveEquipment, Stats: StatValues{Speed: 8}},
+	}
+	if diff := cmp.Diff(wantBreakdown, got.Breakdown); diff != "" {
+		t.Errorf("Hero.FinalStats breakdown mismatch (-want +got):\n%s", diff)
+	}
+
+	want := CalculatedStat{
+		Attack:            1747, // 1000 + 600 + 57 + 90
+		Health:            7484,
+		Speed:             190, // 110 + 45 + 27.5 + 8, rounded down
+		Defense:           600,
+		CriticalHitChance: float32(float64(float32(0.15)) + 0.3 + 0.12),
+		CriticalHitDamage: 1.5,
+		DualAttackChance:  0.05,
+		Effectiveness:     0.25,
+	}
+	if diff := cmp.Diff(want, got.Total); diff != "" {
+		t.Errorf("Hero.FinalStats total mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestHero_FinalStats_percentOfBase(t *testing.T) {
+	h := finalStatsTestHero()
+	got, err := h.FinalStats(Build{
+		State: Level60SixStarFullyAwakened,
+		Gear:  []StatBonus{{Stat: Attack, Value: 500}},
+		Sets:  []GearSet{AttackSet, TorrentSet},
+	})
+	if err != nil {
+		t.Fatalf("Hero.FinalStats returned error: %v", err)
+	}
+
+	// Set percentages apply to the base attack only, not the gear's.
+	if got, want := got.Total.Attack, uint(1000+500+350+100); got != want {
+		t.Errorf("Hero.FinalStats Attack = %d, want %d", got, want)
+	}
+	if got, want := got.Total.Health, uint(5400); got != want {
+		t.Errorf("Hero.FinalStats Health = %d, want %d", got, want)
+	}
+}
+
+func TestHero_FinalStats_errors(t *testing.T) {
+	h := finalStatsTestHero()
+
+	if _, err := h.FinalStats(Build{State: Level50FiveStarNoAwaken}); !errors.Is(err, ErrNoCalculatedStats) {
+		t.Errorf("Hero.FinalStats err = %v, want ErrNoCalculatedStats", err)
+	}
+	if _, err := h.FinalStats(Build{State: Level60SixStarFullyAwakened, SelfDevotion: "Z"}); !errors.Is(err, ErrUnknownDevotionGrade) {
+		t.Errorf("Hero.FinalStats err = %v, want ErrUnknownDevotionGrade", err)
+	}
+}
+
+func TestDevotionGrades_Value(t *testing.T) {
+	g := DevotionGrades{B: 1, A: 2, S: 3, SS: 4, SSS: 5}
+	for i, grade := range []DevotionGrade{GradeB, GradeA, GradeS, GradeSS, GradeSSS} {
+		if got, ok := g.Value(grade); !ok || got != float32(i+1) {
+			t.Errorf("DevotionGrades.Value(%v) = %v, %v, want %v, true", grade, got, ok, i+1)
+		}
+	}
+	if _, ok := g.Value("Z"); ok {
+		t.Error("DevotionGrades.Value(Z) ok = true, want false")
+	}
+}
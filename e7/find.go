@@ -0,0 +1,243 @@
+package e7
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// MinHeroMatchScore is the lowest score of the matches returned by
+// HeroesService.Find.
+const MinHeroMatchScore = 0.5
+
+// defaultHeroAliases maps common community abbreviations, normalized with
+// HeroIDFromName, to hero IDs.
+var defaultHeroAliases = map[string]string{
+	"arby":  "arbiter-vildred",
+	"lqc":   "little-queen-charlotte",
+	"aravi": "apocalypse-ravi",
+	"stene": "specter-tenebria",
+	"ssb":   "seaside-bellona",
+	"bbk":   "blood-blade-karin",
+	"cdom":  "challenger-dominiel",
+	"sbs":   "sage-baal-sezan",
+	"mak":   "martial-artist-ken",
+	"jkise": "judge-kise",
+}
+
+// defaultMoonlightPrefixes are the query prefixes, normalized with
+// HeroIDFromName, that restrict HeroesService.Find to moonlight heroes.
+var defaultMoonlightPrefixes = []string{"ml", "moonlight"}
+
+// WithMoonlightPrefixes adds prefixes to the ones, "ML" and "Moonlight",
+// that restrict a HeroesService.Find query to moonlight heroes, e.g. "moon"
+// or "5* ML". Prefixes are compared after normalization with HeroIDFromName.
+func WithMoonlightPrefixes(prefixes ...string) ClientOption {
+	return func(c *Client) error {
+		for _, p := range prefixes {
+			if p := HeroIDFromName(p); p != "" {
+				c.moonlightPrefixes = append(c.moonlightPrefixes, p)
+			}
+		}
+		return nil
+	}
+}
+
+// WithHeroAliases adds aliases to the table used by HeroesService.Find. It
+// maps an alias, such as "arby", to a hero ID, such as "arbiter-vildred".
+// Aliases are compared after normalization with HeroIDFromName and override
+// the built-in ones.
+func WithHeroAliases(aliases map[string]string) ClientOption {
+	return func(c *Client) error {
+		if c.heroAliases == nil {
+			c.heroAliases = make(map[string]string, len(aliases))
+		}
+		for alias, id := range aliases {
+			c.heroAliases[HeroIDFromName(alias)] = id
+		}
+		return nil
+	}
+}
+
+// HeroMatch is a hero matching a query, with a score between
+// MinHeroMatchScore and 1, where 1 is an exact match of its name, ID or an
+// alias.
+type HeroMatch struct {
+	Hero  HeroSummary
+	Score float64
+}
+
+// Find fetches all hero summaries and returns those matching query, best
+// match first. It is intended to resolve user input, so query is matched
+// loosely:
+//
+//   - against hero names and IDs, exactly or by prefix;
+//   - against the initials and words of hero names;
+//   - with a tolerance for misspellings, by edit distance;
+//   - against a table of common aliases, such as "arby" for Arbiter Vildred,
+//     which can be extended with WithHeroAliases.
+//
+// A query starting with "ML" or "Moonlight", followed by a space, only
+// matches moonlight heroes, so "ML Ken" finds Martial Artist Ken. More
+// prefixes can be added with WithMoonlightPrefixes.
+func (s *HeroesService) Find(ctx context.Context, query string) ([]HeroMatch, *Response, error) {
+	heroes, resp, err := s.ListSummaries(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	aliases := defaultHeroAliases
+	if len(s.client.heroAliases) > 0 {
+		aliases = make(map[string]string, len(defaultHeroAliases)+len(s.client.heroAliases))
+		for alias, id := range defaultHeroAliases {
+			aliases[alias] = id
+		}
+		for alias, id := range s.client.heroAliases {
+			aliases[alias] = id
+		}
+	}
+	prefixes := append(append([]string(nil), defaultMoonlightPrefixes...), s.client.moonlightPrefixes...)
+	return findHeroes(query, heroes, aliases, prefixes), resp, nil
+}
+
+// findHeroes implements Find on the given heroes, aliases and moonlight
+// prefixes.
+func findHeroes(query string, heroes []HeroSummary, aliases map[string]string, moonlightPrefixes []string) []HeroMatch {
+	q := HeroIDFromName(query)
+	if q == "" {
+		return nil
+	}
+	aliased := aliases[q]
+
+	// Strip the longest matching prefix, e.g. "5-ml" rather than "5" if both
+	// are set.
+	moonlightOnly := false
+	if aliased == "" {
+		longest := ""
+		for _, p := range moonlightPrefixes {
+			if len(p) > len(longest) && strings.HasPrefix(q, p+"-") {
+				longest = p
+			}
+		}
+		if longest != "" {
+			q, moonlightOnly = strings.TrimPrefix(q, longest+"-"), true
+		}
+	}
+
+	var matches []HeroMatch
+	for _, h := range heroes {
+		if moonlightOnly && !h.Moonlight {
+			continue
+		}
+		score := 0.0
+		if h.UUID == aliased {
+			score = 1
+		} else {
+			for _, key := range []string{h.UUID, HeroIDFromName(h.Name)} {
+				if s := matchScore(q, key); s > score {
+					score = s
+				}
+			}
+		}
+		if score >= MinHeroMatchScore {
+			matches = append(matches, HeroMatch{Hero: h, Score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Hero.Name < matches[j].Hero.Name
+	})
+	return matches
+}
+
+// matchScore scores how well the normalized query q matches the hyphenated
+// key, from 0 for no match to 1 for an exact match.
+func matchScore(q, key string) float64 {
+	if q == key {
+		return 1
+	}
+
+	score := 0.0
+	consider := func(s float64) {
+		if s > score {
+			score = s
+		}
+	}
+	if strings.HasPrefix(key, q) {
+		consider(0.75 + 0.2*ratio(q, key))
+	}
+
+	words := strings.Split(key, "-")
+	if len(words) > 1 {
+		var initials strings.Builder
+		for _, w := range words {
+			if w != "" {
+				initials.WriteByte(w[0])
+			}
+		}
+		if initials.String() == q {
+			consider(0.85)
+		}
+	}
+	for _, w := range words {
+		switch {
+		case w == q:
+			consider(0.8)
+		case strings.HasPrefix(w, q):
+			consider(0.5 + 0.25*ratio(q, w))
+		}
+	}
+
+	if sim := 1 - float64(editDistance(q, key))/float64(maxLen(q, key)); sim >= 0.6 {
+		consider(0.9 * sim)
+	}
+	return score
+}
+
+// ratio returns the length of a relative to the length of b.
+func ratio(a, b string) float64 {
+	return float64(len([]rune(a))) / float64(len([]rune(b)))
+}
+
+func maxLen(a, b string) int {
+	n, m := len([]rune(a)), len([]rune(b))
+	if n < m {
+		return m
+	}
+	return n
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	prev := make([]int, len(t)+1)
+	curr := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		curr[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(t)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
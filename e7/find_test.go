@@ -0,0 +1,158 @@
+package e7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var findTestHeroes = []HeroSummary{
+	{UUID: "ken", Name: "Ken"},
+	{UUID: "martial-artist-ken", Name: "Martial Artist Ken", Moonlight: true},
+	{UUID: "vildred", Name: "Vildred"},
+	{UUID: "arbiter-vildred", Name: "Arbiter Vildred", Moonlight: true},
+	{UUID: "charlotte", Name: "Charlotte"},
+	{UUID: "little-queen-charlotte", Name: "Little Queen Charlotte", Moonlight: true},
+	{UUID: "sage-baal-sezan", Name: "Sage Baal & Sezan"},
+}
+
+func TestFindHeroes(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string // ID of the best match
+	}{
+		{query: "Ken", want: "ken"},
+		{query: "ML Ken", want: "martial-artist-ken"},
+		{query: "moonlight vildred", want: "arbiter-vildred"},
+		{query: "arby", want: "arbiter-vildred"},
+		{query: "LQC", want: "little-queen-charlotte"},
+		{query: "Sage Baal and Sezan", want: "sage-baal-sezan"},
+		{query: "Vilderd", want: "vildred"},
+		{query: "charlot", want: "charlotte"},
+		{query: "arbiter", want: "arbiter-vildred"},
+	}
+
+	for _, tt := range tests {
+		got := findHeroes(tt.query, findTestHeroes, defaultHeroAliases, defaultMoonlightPrefixes)
+		if len(got) == 0 {
+			t.Errorf("findHeroes(%q) returned no matches, want %q", tt.query, tt.want)
+			continue
+		}
+		if got[0].Hero.UUID != tt.want {
+			t.Errorf("findHeroes(%q) best match = %q, want %q (all: %v)", tt.query, got[0].Hero.UUID, tt.want, got)
+		}
+		for i, m := range got {
+			if m.Score < MinHeroMatchScore || m.Score > 1 {
+				t.Errorf("findHeroes(%q)[%d].Score = %v, want in [%v, 1]", tt.query, i, m.Score, MinHeroMatchScore)
+			}
+			if i > 0 && m.Score > got[i-1].Score {
+				t.Errorf("findHeroes(%q) is not sorted by score: %v", tt.query, got)
+			}
+		}
+	}
+}
+
+func TestFindHeroes_noMatch(t *testing.T) {
+	for _, q := range []string{"", "  ", "zzzzzz"} {
+		if got := findHeroes(q, findTestHeroes, defaultHeroAliases, defaultMoonlightPrefixes); len(got) != 0 {
+			t.Errorf("findHeroes(%q) = %v, want no matches", q, got)
+		}
+	}
+}
+
+func TestFindHeroes_exactScore(t *testing.T) {
+	got := findHeroes("Vildred", findTestHeroes, defaultHeroAliases, defaultMoonlightPrefixes)
+
+	want := []HeroMatch{{Hero: findTestHeroes[2], Score: 1}}
+	if diff := cmp.Diff(want, got[:1]); diff != "" {
+		t.Errorf("findHeroes mismatch (-want +got):\n%s", diff)
+	}
+	if len(got) < 2 || got[1].Hero.UUID != "arbiter-vildred" {
+		t.Errorf("findHeroes did not suggest arbiter-vildred: %v", got)
+	}
+}
+
+func TestHeroesService_Find_moonlightPrefixes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [
+			{"_id": "ken", "name": "Ken"},
+			{"_id": "martial-artist-ken", "name": "Martial Artist Ken", "moonlight": true}
+		]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Heroes.Find(ctx, "moon ken")
+	if err != nil {
+		t.Fatalf("Heroes.Find returned error: %v", err)
+	}
+	for _, m := range got {
+		if m.Hero.UUID == "martial-artist-ken" {
+			t.Fatalf("Heroes.Find(moon ken) = %v, want no moonlight match without the prefix", got)
+		}
+	}
+
+	if err := WithMoonlightPrefixes("moon", "5* ML")(client); err != nil {
+		t.Fatal(err)
+	}
+	for _, q := range []string{"moon ken", "5* ML Ken", "ML Ken"} {
+		got, _, err := client.Heroes.Find(ctx, q)
+		if err != nil {
+			t.Fatalf("Heroes.Find returned error: %v", err)
+		}
+		if len(got) != 1 || got[0].Hero.UUID != "martial-artist-ken" {
+			t.Errorf("Heroes.Find(%q) = %v, want only martial-artist-ken", q, got)
+		}
+	}
+}
+
+func TestHeroesService_Find(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"results": [
+			{"_id": "sage-baal-sezan", "name": "Sage Baal & Sezan"},
+			{"_id": "baal-sezan", "name": "Baal & Sezan"}
+		]}`)
+	})
+
+	ctx := context.Background()
+	if err := WithHeroAliases(map[string]string{"Old Man": "baal-sezan"})(client); err != nil {
+		t.Fatal(err)
+	}
+	got, _, err := client.Heroes.Find(ctx, "old man")
+	if err != nil {
+		t.Fatalf("Heroes.Find returned error: %v", err)
+	}
+	if len(got) == 0 || got[0].Hero.UUID != "baal-sezan" || got[0].Score != 1 {
+		t.Errorf("Heroes.Find(old man) = %v, want baal-sezan with score 1", got)
+	}
+
+	got, _, err = client.Heroes.Find(ctx, "SBS")
+	if err != nil {
+		t.Fatalf("Heroes.Find returned error: %v", err)
+	}
+	if len(got) == 0 || got[0].Hero.UUID != "sage-baal-sezan" {
+		t.Errorf("Heroes.Find(SBS) = %v, want sage-baal-sezan first", got)
+	}
+
+	// Test s.client.NewRequest failure
+	client.BaseURL.Path = ""
+	got, resp, err := client.Heroes.Find(ctx, "h")
+	if got != nil {
+		t.Errorf("client.BaseURL.Path='' Find = %#v, want nil", got)
+	}
+	if resp != nil {
+		t.Errorf("client.BaseURL.Path='' Find resp = %#v, want nil", resp)
+	}
+	if err == nil {
+		t.Error("client.BaseURL.Path='' Find err = nil, want error")
+	}
+}
@@ -34,10 +34,11 @@ type Hero struct {
 	SpecialtyChange SpecialtyChange `json:"specialty_change,omitempty"`
 	Assets          Assets          `json:"assets,omitempty"`
 
-	// TODO: See issue #3.
-	Buffs   []interface{} `json:"buffs,omitempty"`
-	Debuffs []interface{} `json:"debuffs,omitempty"`
-	Common  []interface{} `json:"common,omitempty"`
+	// Buffs, Debuffs and Common are the effects referenced by the hero's
+	// skills. Use EffectResolver to look up the effects of a skill.
+	Buffs   []Buff   `json:"buffs,omitempty"`
+	Debuffs []Debuff `json:"debuffs,omitempty"`
+	Common  []Common `json:"common,omitempty"`
 
 	ExclusiveEquipments []ExclusiveEquipment                  `json:"exclusiveEquipments,omitempty"`
 	CalculatedStats     map[PreCalculatedState]CalculatedStat `json:"calculatedStatus,omitempty"`
@@ -96,6 +97,37 @@ type DevotionGrades struct {
 	SSS float32 `json:"SSS,omitempty"`
 }
 
+// DevotionGrade represents a devotion grade, from B to SSS.
+type DevotionGrade string
+
+// Devotion grade.
+const (
+	GradeB   DevotionGrade = "B"
+	GradeA   DevotionGrade = "A"
+	GradeS   DevotionGrade = "S"
+	GradeSS  DevotionGrade = "SS"
+	GradeSSS DevotionGrade = "SSS"
+)
+
+// Value returns the multiplier of grade. The returned bool is false if
+// grade is not a known grade.
+func (g DevotionGrades) Value(grade DevotionGrade) (float32, bool) {
+	switch grade {
+	case GradeB:
+		return g.B, true
+	case GradeA:
+		return g.A, true
+	case GradeS:
+		return g.S, true
+	case GradeSS:
+		return g.SS, true
+	case GradeSSS:
+		return g.SSS, true
+	default:
+		return 0, false
+	}
+}
+
 // Slots represents the position in a party.
 type Slots struct {
 	One   bool `json:"1,omitempty"`
@@ -371,16 +403,83 @@ type CalculatedStat struct {
 	EffectResistance  float32 `json:"efr,omitempty"`
 }
 
+// HeroSummary represents the identifying details of an Epic Seven hero,
+// enough to display it in a list. Decoding summaries skips the skills,
+// zodiac tree, costs and stats of a Hero, so it is much cheaper.
+type HeroSummary struct {
+	UUID      string    `json:"_id,omitempty"`
+	ID        string    `json:"id,omitempty"`
+	Name      string    `json:"name,omitempty"`
+	Moonlight bool      `json:"moonlight,omitempty"`
+	Rarity    uint      `json:"rarity,omitempty"`
+	Attribute Attribute `json:"attribute,omitempty"`
+	Role      Role      `json:"role,omitempty"`
+	Assets    Assets    `json:"assets,omitempty"`
+}
+
+// Summary returns the summary of h.
+func (h *Hero) Summary() HeroSummary {
+	return HeroSummary{
+		UUID:      h.UUID,
+		ID:        h.ID,
+		Name:      h.Name,
+		Moonlight: h.Moonlight,
+		Rarity:    h.Rarity,
+		Attribute: h.Attribute,
+		Role:      h.Role,
+		Assets:    h.Assets,
+	}
+}
+
+// hero returns a Hero with the fields of s.
+func (s HeroSummary) hero() *Hero {
+	return &Hero{
+		UUID:      s.UUID,
+		ID:        s.ID,
+		Name:      s.Name,
+		Moonlight: s.Moonlight,
+		Rarity:    s.Rarity,
+		Attribute: s.Attribute,
+		Role:      s.Role,
+		Assets:    s.Assets,
+	}
+}
+
+// HeroSummariesResponse is an EpicSevenDB API response that contains a list
+// of heroes, decoded as summaries.
+type HeroSummariesResponse struct {
+	Results  []HeroSummary `json:"results,omitempty"`
+	Metadata Metadata      `json:"meta,omitempty"`
+}
+
+func (r *HeroSummariesResponse) metadata() Metadata {
+	return r.Metadata
+}
+
 // HeroesResponse is an EpicSevenDB API response that contains a list
 // of heroes.
 type HeroesResponse struct {
 	Results  []Hero   `json:"results,omitempty"`
-	Metadata Metadata `json:"metadata,omitempty"`
+	Metadata Metadata `json:"meta,omitempty"`
+}
+
+func (r *HeroesResponse) metadata() Metadata {
+	return r.Metadata
 }
 
 // GetByID fetches a hero by ID. The ID is the hero's name in lowercase. Heroes with space in their names
 // must be hyphenated. e.g. Little Queen Charlotte would be little-queen-charlotte.
-func (s *HeroesService) GetByID(ctx context.Context, hero string) (*Hero, *http.Response, error) {
+//
+// If the hero does not exist, the returned error matches ErrHeroNotFound. If
+// several heroes are returned and none has exactly the requested ID, the
+// returned error is an *AmbiguousResultError.
+//
+// If the client was created with WithHeroIDNormalization, hero may also be
+// a display name such as "Little Queen Charlotte"; see HeroIDFromName.
+func (s *HeroesService) GetByID(ctx context.Context, hero string) (*Hero, *Response, error) {
+	if s.client.normalizeHeroIDs {
+		hero = HeroIDFromName(hero)
+	}
 	u := fmt.Sprintf("hero/%v", hero)
 	req, err := s.client.NewRequest(http.MethodGet, u)
 	if err != nil {
@@ -389,15 +488,22 @@ func (s *HeroesService) GetByID(ctx context.Context, hero string) (*Hero, *http.
 
 	response := new(HeroesResponse)
 	resp, err := s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, resp, notFound(err, "hero", hero)
+	}
+
+	i, err := pickResult(resp, "hero", hero, len(response.Results), func(i int) string {
+		return response.Results[i].UUID
+	})
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return &response.Results[0], resp, nil
+	return &response.Results[i], resp, nil
 }
 
 // List fetches all heroes.
-func (s *HeroesService) List(ctx context.Context) ([]Hero, *http.Response, error) {
+func (s *HeroesService) List(ctx context.Context) ([]Hero, *Response, error) {
 	u := fmt.Sprintf("hero")
 	req, err := s.client.NewRequest(http.MethodGet, u)
 	if err != nil {
@@ -412,3 +518,57 @@ func (s *HeroesService) List(ctx context.Context) ([]Hero, *http.Response, error
 
 	return response.Results, resp, nil
 }
+
+// ListSummaries fetches all heroes, decoding only their summaries. Use it
+// instead of List when the full hero details are not needed.
+func (s *HeroesService) ListSummaries(ctx context.Context) ([]HeroSummary, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "hero")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(HeroSummariesResponse)
+	resp, err := s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Results, resp, nil
+}
+
+// HeroBatchResult is the outcome of fetching one hero in a batch.
+type HeroBatchResult struct {
+	// ID is the requested hero ID.
+	ID       string
+	Hero     *Hero
+	Response *Response
+	Err      error
+}
+
+// GetByIDs fetches several heroes by ID concurrently, making at most
+// opts.Concurrency requests at a time. The results are in the same order as
+// ids, and repeated IDs are fetched only once. Failing to fetch a hero does
+// not stop the batch; its error is reported in its result.
+//
+// If ctx is canceled, no further requests are started and the heroes not
+// yet fetched get ctx.Err() as their error, which is also returned.
+func (s *HeroesService) GetByIDs(ctx context.Context, ids []string, opts *BatchOptions) ([]HeroBatchResult, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+
+	unique, index := dedupe(ids)
+	fetched := make([]HeroBatchResult, len(unique))
+	runBatch(ctx, len(unique), opts.concurrency(), func(i int) {
+		h, resp, err := s.GetByID(ctx, unique[i])
+		fetched[i] = HeroBatchResult{ID: unique[i], Hero: h, Response: resp, Err: err}
+	}, func(i int) {
+		fetched[i] = HeroBatchResult{ID: unique[i], Err: ctx.Err()}
+	})
+
+	results := make([]HeroBatchResult, len(ids))
+	for i, j := range index {
+		results[i] = fetched[j]
+	}
+	return results, ctx.Err()
+}
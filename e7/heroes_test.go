@@ -2,8 +2,11 @@ package e7
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -111,3 +114,224 @@ func TestHeroesService_List(t *testing.T) {
 		t.Error("client.BaseURL.Path='' List err = nil, want error")
 	}
 }
+
+func TestHeroesService_GetByID_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero/h", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": "Not found"}`)
+	})
+
+	_, _, err := client.Heroes.GetByID(context.Background(), "h")
+	if !errors.Is(err, ErrHeroNotFound) {
+		t.Errorf("Heroes.GetByID err = %v, want ErrHeroNotFound", err)
+	}
+}
+
+func TestHeroesService_GetByID_noResults(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero/h", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": []}`)
+	})
+
+	got, resp, err := client.Heroes.GetByID(context.Background(), "h")
+	if got != nil {
+		t.Errorf("Heroes.GetByID = %#v, want nil", got)
+	}
+	if resp == nil {
+		t.Error("Heroes.GetByID resp = nil, want response")
+	}
+	if !errors.Is(err, ErrHeroNotFound) {
+		t.Errorf("Heroes.GetByID err = %v, want ErrHeroNotFound", err)
+	}
+}
+
+func TestHeroesService_GetByID_multipleResults(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero/h", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [{"_id": "h-2", "name": "H2"}, {"_id": "h", "name": "H"}]}`)
+	})
+	mux.HandleFunc("/hero/x", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [{"_id": "x-1"}, {"_id": "x-2"}]}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Heroes.GetByID(ctx, "h")
+	if err != nil {
+		t.Fatalf("Heroes.GetByID returned error: %v", err)
+	}
+	if want := (&Hero{UUID: "h", Name: "H"}); !cmp.Equal(want, got) {
+		t.Errorf("Heroes.GetByID mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	_, _, err = client.Heroes.GetByID(ctx, "x")
+	var ambiguous *AmbiguousResultError
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("Heroes.GetByID err = %v, want *AmbiguousResultError", err)
+	}
+	if want := []string{"x-1", "x-2"}; !cmp.Equal(want, ambiguous.Candidates) {
+		t.Errorf("AmbiguousResultError.Candidates mismatch (-want +got):\n%s", cmp.Diff(want, ambiguous.Candidates))
+	}
+	if !errors.Is(err, ErrAmbiguousResult) {
+		t.Error("AmbiguousResultError does not match ErrAmbiguousResult")
+	}
+}
+
+func TestHeroesService_ListSummaries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+		{
+			"results": [
+				{
+					"_id": "h",
+					"id": "1",
+					"name": "H",
+					"moonlight": true,
+					"rarity": 5,
+					"attribute": "dark",
+					"role": "assassin",
+					"assets": {"icon": "i"},
+					"skills": [{"name": "s", "enhancements": [{"costs": [{"count": 1}]}]}],
+					"zodiac_tree": [{"name": "z"}]
+				}
+			],
+			"meta": {
+				"requestDate": "date",
+				"apiVersion": "1"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Heroes.ListSummaries(ctx)
+	if err != nil {
+		t.Errorf("Heroes.ListSummaries returned error: %v", err)
+	}
+
+	want := []HeroSummary{
+		{
+			UUID:      "h",
+			ID:        "1",
+			Name:      "H",
+			Moonlight: true,
+			Rarity:    5,
+			Attribute: Dark,
+			Role:      Thief,
+			Assets:    Assets{Icon: "i"},
+		}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Heroes.ListSummaries mismatch (-want +got):\n%s", diff)
+	}
+
+	// Test s.client.NewRequest failure
+	client.BaseURL.Path = ""
+	got, resp, err := client.Heroes.ListSummaries(ctx)
+	if got != nil {
+		t.Errorf("client.BaseURL.Path='' ListSummaries = %#v, want nil", got)
+	}
+	if resp != nil {
+		t.Errorf("client.BaseURL.Path='' ListSummaries resp = %#v, want nil", resp)
+	}
+	if err == nil {
+		t.Error("client.BaseURL.Path='' ListSummaries err = nil, want error")
+	}
+}
+
+func TestHero_Summary(t *testing.T) {
+	h := &Hero{
+		UUID:      "h",
+		ID:        "1",
+		Name:      "H",
+		Rarity:    3,
+		Attribute: Ice,
+		Role:      Knight,
+		Skills:    []Skill{{Name: "s"}},
+	}
+
+	want := HeroSummary{UUID: "h", ID: "1", Name: "H", Rarity: 3, Attribute: Ice, Role: Knight}
+	if diff := cmp.Diff(want, h.Summary()); diff != "" {
+		t.Errorf("Hero.Summary mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestHeroesService_GetByIDs(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	mux.HandleFunc("/hero/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/hero/")
+		mu.Lock()
+		calls[id]++
+		mu.Unlock()
+
+		if id == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"results": [{"_id": %q}]}`, id)
+	})
+
+	ids := []string{"a", "missing", "b", "a"}
+	got, err := client.Heroes.GetByIDs(context.Background(), ids, &BatchOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatalf("Heroes.GetByIDs returned error: %v", err)
+	}
+	if len(got) != len(ids) {
+		t.Fatalf("Heroes.GetByIDs returned %d results, want %d", len(got), len(ids))
+	}
+
+	for i, id := range ids {
+		if got[i].ID != id {
+			t.Errorf("result %d is for %q, want %q", i, got[i].ID, id)
+		}
+		if id == "missing" {
+			if !errors.Is(got[i].Err, ErrHeroNotFound) || got[i].Hero != nil {
+				t.Errorf("result %d = %+v, want ErrHeroNotFound", i, got[i])
+			}
+			continue
+		}
+		if got[i].Err != nil || got[i].Hero == nil || got[i].Hero.UUID != id {
+			t.Errorf("result %d = %+v, want hero %q", i, got[i], id)
+		}
+	}
+
+	if want := map[string]int{"a": 1, "b": 1, "missing": 1}; !cmp.Equal(want, calls) {
+		t.Errorf("server calls mismatch (-want +got):\n%s", cmp.Diff(want, calls))
+	}
+}
+
+func TestHeroesService_GetByIDs_canceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/hero/", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		fmt.Fprint(w, `{"results": [{"_id": "h"}]}`)
+	})
+
+	got, err := client.Heroes.GetByIDs(ctx, []string{"a", "b", "c"}, &BatchOptions{Concurrency: 1})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Heroes.GetByIDs err = %v, want context.Canceled", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("Heroes.GetByIDs returned %d results, want 3", len(got))
+	}
+	for _, r := range got[1:] {
+		if !errors.Is(r.Err, context.Canceled) {
+			t.Errorf("result for %q err = %v, want context.Canceled", r.ID, r.Err)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package e7
+
+import (
+	"context"
+	"strings"
+	"unicode"
+)
+
+// WithHeroIDNormalization makes HeroesService.GetByID accept hero display
+// names as well as IDs, converting them with HeroIDFromName.
+func WithHeroIDNormalization() ClientOption {
+	return func(c *Client) error {
+		c.normalizeHeroIDs = true
+		return nil
+	}
+}
+
+// foldedRunes maps accented and ligature letters to their ASCII spelling.
+var foldedRunes = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a",
+	'æ': "ae", 'ç': "c", 'č': "c",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ī': "i", 'ı': "i",
+	'ñ': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ō': "o",
+	'œ': "oe", 'ß': "ss", 'š': "s",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ū': "u",
+	'ý': "y", 'ÿ': "y", 'ž': "z",
+}
+
+// HeroIDFromName returns the API ID of the hero with the given display name,
+// e.g. "little-queen-charlotte" for "Little Queen Charlotte".
+//
+// The name is lowercased and accents are removed. Apostrophes and periods
+// are dropped, so "Ainos 2.0" becomes "ainos-20", and every other run of
+// spaces or punctuation, such as " & ", becomes a single hyphen. An ID is
+// returned unchanged.
+func HeroIDFromName(name string) string {
+	var b strings.Builder
+	sep := false
+	write := func(s string) {
+		if sep && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		sep = false
+		b.WriteString(s)
+	}
+
+	for _, r := range strings.ToLower(name) {
+		if f, ok := foldedRunes[r]; ok {
+			write(f)
+			continue
+		}
+		switch {
+		case r == '\'' || r == '’' || r == '‘' || r == '.':
+		case unicode.Is(unicode.Mn, r):
+			// Combining marks of decomposed accented letters.
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			write(string(r))
+		default:
+			sep = true
+		}
+	}
+	return b.String()
+}
+
+// HeroNames maps between hero IDs and display names for a list of heroes.
+// It is safe for concurrent use.
+type HeroNames struct {
+	names map[string]string // by ID
+	ids   map[string]string // by normalized name and ID
+}
+
+// NewHeroNames returns a HeroNames for the given heroes, as returned by
+// HeroesService.ListSummaries.
+func NewHeroNames(heroes []HeroSummary) *HeroNames {
+	n := &HeroNames{
+		names: make(map[string]string, len(heroes)),
+		ids:   make(map[string]string, 2*len(heroes)),
+	}
+	for _, h := range heroes {
+		n.names[h.UUID] = h.Name
+		n.ids[h.UUID] = h.UUID
+	}
+	// Names are indexed after IDs so that they win if both normalize to the
+	// same key.
+	for _, h := range heroes {
+		n.ids[HeroIDFromName(h.Name)] = h.UUID
+	}
+	return n
+}
+
+// Name returns the display name of the hero with the given ID. The returned
+// bool is false if the hero is unknown.
+func (n *HeroNames) Name(id string) (string, bool) {
+	name, ok := n.names[id]
+	return name, ok
+}
+
+// ID returns the ID of the hero with the given display name or ID. Unlike
+// HeroIDFromName, it only returns IDs of known heroes, so it is not misled
+// by heroes whose ID does not follow their name. The returned bool is false
+// if the hero is unknown.
+func (n *HeroNames) ID(name string) (string, bool) {
+	if id, ok := n.ids[name]; ok {
+		return id, true
+	}
+	id, ok := n.ids[HeroIDFromName(name)]
+	return id, ok
+}
+
+// Names fetches all hero summaries and returns a HeroNames for them.
+func (s *HeroesService) Names(ctx context.Context) (*HeroNames, *Response, error) {
+	heroes, resp, err := s.ListSummaries(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	return NewHeroNames(heroes), resp, nil
+}
@@ -0,0 +1,190 @@
+package e7
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+var record = flag.Bool("record", false, "record testdata/heroes.json from the EpicSevenDB API")
+
+var recordOnce sync.Once
+
+// knownHeroes returns the heroes of testdata/heroes.json, which holds the
+// _id and name of heroes of a /hero response. Run the tests with -record to
+// replace it with the full hero list of the live API. Until it has been
+// recorded, it is a hand-written list, in name order, of heroes and the IDs
+// used in their EpicSevenDB URLs.
+func knownHeroes(t *testing.T) (data []byte, heroes []HeroSummary) {
+	t.Helper()
+	if *record {
+		recordOnce.Do(func() { recordHeroes(t) })
+	}
+	data, err := ioutil.ReadFile("testdata/heroes.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	response := new(HeroSummariesResponse)
+	if err := json.Unmarshal(data, response); err != nil {
+		t.Fatal(err)
+	}
+	return data, response.Results
+}
+
+// recordHeroes writes the _id and name of every hero listed by the live API
+// to testdata/heroes.json.
+func recordHeroes(t *testing.T) {
+	t.Helper()
+	heroes, _, err := NewClient().Heroes.ListSummaries(context.Background())
+	if err != nil {
+		t.Fatalf("recording hero list: %v", err)
+	}
+
+	type hero struct {
+		UUID string `json:"_id"`
+		Name string `json:"name"`
+	}
+	var recorded struct {
+		Results []hero `json:"results"`
+	}
+	for _, h := range heroes {
+		recorded.Results = append(recorded.Results, hero{UUID: h.UUID, Name: h.Name})
+	}
+	data, err := json.MarshalIndent(recorded, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile("testdata/heroes.json", append(data, '\n'), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHeroIDFromName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Little Queen Charlotte", "little-queen-charlotte"},
+		{"little-queen-charlotte", "little-queen-charlotte"},
+		{"  Ras  ", "ras"},
+		{"Ainos 2.0", "ainos-20"},
+		{"Baal & Sezan", "baal-sezan"},
+		{"Sage Baal & Sezan", "sage-baal-sezan"},
+		{"Ruele of Light", "ruele-of-light"},
+		{"Kiris's Blade", "kiriss-blade"},
+		{"Kiris’ Blade", "kiris-blade"},
+		{"Lidica\u0301", "lidica"},
+		{"Lidicá", "lidica"},
+		{"Æther Ørn", "aether-orn"},
+		{"A.D. / B_C", "ad-b-c"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := HeroIDFromName(tt.name); got != tt.want {
+			t.Errorf("HeroIDFromName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestHeroIDFromName_knownHeroes(t *testing.T) {
+	_, heroes := knownHeroes(t)
+
+	for _, h := range heroes {
+		if got := HeroIDFromName(h.Name); got != h.UUID {
+			t.Errorf("HeroIDFromName(%q) = %q, want %q", h.Name, got, h.UUID)
+		}
+		if got := HeroIDFromName(h.UUID); got != h.UUID {
+			t.Errorf("HeroIDFromName(%q) = %q, want it unchanged", h.UUID, got)
+		}
+	}
+}
+
+func TestHeroNames(t *testing.T) {
+	_, heroes := knownHeroes(t)
+	names := NewHeroNames(append(heroes, HeroSummary{UUID: "rimuru-tempest", Name: "Rimuru"}))
+
+	for _, h := range heroes {
+		if got, ok := names.Name(h.UUID); !ok || got != h.Name {
+			t.Errorf("HeroNames.Name(%q) = %q, %v, want %q, true", h.UUID, got, ok, h.Name)
+		}
+		if got, ok := names.ID(h.Name); !ok || got != h.UUID {
+			t.Errorf("HeroNames.ID(%q) = %q, %v, want %q, true", h.Name, got, ok, h.UUID)
+		}
+	}
+
+	// IDs that do not follow the name are found by name and ID.
+	for _, in := range []string{"Rimuru", "rimuru-tempest"} {
+		if got, ok := names.ID(in); !ok || got != "rimuru-tempest" {
+			t.Errorf("HeroNames.ID(%q) = %q, %v, want %q, true", in, got, ok, "rimuru-tempest")
+		}
+	}
+
+	if got, ok := names.Name("unknown"); ok {
+		t.Errorf("HeroNames.Name(unknown) = %q, true, want false", got)
+	}
+	if got, ok := names.ID("Unknown Hero"); ok {
+		t.Errorf("HeroNames.ID(Unknown Hero) = %q, true, want false", got)
+	}
+}
+
+func TestHeroesService_Names(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	data, _ := knownHeroes(t)
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		w.Write(data)
+	})
+
+	names, _, err := client.Heroes.Names(context.Background())
+	if err != nil {
+		t.Fatalf("Heroes.Names returned error: %v", err)
+	}
+	if got, ok := names.ID("Sage Baal & Sezan"); !ok || got != "sage-baal-sezan" {
+		t.Errorf("HeroNames.ID = %q, %v, want %q, true", got, ok, "sage-baal-sezan")
+	}
+
+	// Test s.client.NewRequest failure
+	client.BaseURL.Path = ""
+	names, resp, err := client.Heroes.Names(context.Background())
+	if names != nil {
+		t.Errorf("client.BaseURL.Path='' Names = %#v, want nil", names)
+	}
+	if resp != nil {
+		t.Errorf("client.BaseURL.Path='' Names resp = %#v, want nil", resp)
+	}
+	if err == nil {
+		t.Error("client.BaseURL.Path='' Names err = nil, want error")
+	}
+}
+
+func TestHeroesService_GetByID_normalized(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero/sage-baal-sezan", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [{"_id": "sage-baal-sezan"}]}`)
+	})
+
+	if _, _, err := client.Heroes.GetByID(context.Background(), "sage-baal-sezan"); err != nil {
+		t.Errorf("Heroes.GetByID returned error: %v", err)
+	}
+
+	if err := WithHeroIDNormalization()(client); err != nil {
+		t.Fatal(err)
+	}
+	hero, _, err := client.Heroes.GetByID(context.Background(), "Sage Baal & Sezan")
+	if err != nil {
+		t.Fatalf("Heroes.GetByID returned error: %v", err)
+	}
+	if got, want := hero.UUID, "sage-baal-sezan"; got != want {
+		t.Errorf("Heroes.GetByID UUID = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,41 @@
+package e7
+
+// ItemCategory represents the category of an Epic Seven item. It holds the
+// category as returned by the API, so categories the API adds later are
+// kept rather than failing the decoding of the item catalog. The zero value
+// means that the item has no category.
+type ItemCategory string
+
+// Item category.
+const (
+	// Currency items, such as gold and stigma.
+	Currency ItemCategory = "currency"
+	// Rune items used to awaken heroes.
+	Rune ItemCategory = "rune"
+	// Catalyst items used to awaken and enhance heroes.
+	Catalyst ItemCategory = "catalyst"
+	// Material items, such as molagoras.
+	Material ItemCategory = "material"
+	// Consumable items, such as stamina potions.
+	Consumable ItemCategory = "consumable"
+	// Special items that fit no other category.
+	Special ItemCategory = "special"
+)
+
+var itemCategories = map[ItemCategory]bool{
+	Currency:   true,
+	Rune:       true,
+	Catalyst:   true,
+	Material:   true,
+	Consumable: true,
+	Special:    true,
+}
+
+func (c ItemCategory) String() string {
+	return string(c)
+}
+
+// Known reports whether c is one of the defined item categories.
+func (c ItemCategory) Known() bool {
+	return itemCategories[c]
+}
@@ -0,0 +1,66 @@
+package e7
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestItemCategory_String(t *testing.T) {
+	tests := []struct {
+		in   ItemCategory
+		want string
+	}{
+		{in: Currency, want: "currency"},
+		{in: Rune, want: "rune"},
+		{in: Catalyst, want: "catalyst"},
+		{in: Material, want: "material"},
+		{in: Consumable, want: "consumable"},
+		{in: Special, want: "special"},
+		{in: ItemCategory("event"), want: "event"},
+	}
+
+	for _, tt := range tests {
+		got := tt.in.String()
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("ItemCategory.String mismatch (-want, +got):\n%s", diff)
+		}
+	}
+}
+
+func TestItemCategory_Known(t *testing.T) {
+	for _, c := range []ItemCategory{Currency, Rune, Catalyst, Material, Consumable, Special} {
+		if !c.Known() {
+			t.Errorf("ItemCategory(%q).Known() = false, want true", c)
+		}
+	}
+	for _, c := range []ItemCategory{"", "event"} {
+		if c.Known() {
+			t.Errorf("ItemCategory(%q).Known() = true, want false", c)
+		}
+	}
+}
+
+func TestItem_unknownCategory(t *testing.T) {
+	var items []Item
+	data := `[{"identifier": "a", "category": "event"}, {"identifier": "b"}, {"identifier": "c", "category": "rune"}]`
+	if err := json.Unmarshal([]byte(data), &items); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	want := []ItemCategory{"event", "", Rune}
+	for i, item := range items {
+		if item.Category != want[i] {
+			t.Errorf("item %q Category = %q, want %q", item.Identifier, item.Category, want[i])
+		}
+	}
+
+	b, err := json.Marshal(items[0])
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	if diff := cmp.Diff(`{"identifier":"a","category":"event","assets":{}}`, string(b)); diff != "" {
+		t.Errorf("json.Marshal mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,130 @@
+package e7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ItemsService handles communication with the item related
+// methods of the EpicSevenDB API.
+type ItemsService service
+
+// Item represents an Epic Seven item, such as a rune, catalyst or currency.
+type Item struct {
+	UUID        string       `json:"_id,omitempty"`
+	Identifier  string       `json:"identifier,omitempty"`
+	Name        string       `json:"name,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Category    ItemCategory `json:"category,omitempty"`
+	Grade       uint         `json:"grade,omitempty"`
+	// Attribute is the attribute of runes and catalysts tied to one. It is
+	// nil for other items.
+	Attribute *Attribute `json:"attribute,omitempty"`
+	Type1     string     `json:"type1,omitempty"`
+	Type2     *string    `json:"type2,omitempty"`
+	Assets    Assets     `json:"assets,omitempty"`
+}
+
+// ItemsResponse is an EpicSevenDB API response that contains a list
+// of items.
+type ItemsResponse struct {
+	Results  []Item   `json:"results,omitempty"`
+	Metadata Metadata `json:"meta,omitempty"`
+}
+
+func (r *ItemsResponse) metadata() Metadata {
+	return r.Metadata
+}
+
+// GetByID fetches an item by its identifier, as found in
+// NodeCost.Identifier and EnhancementCost.Identifier.
+//
+// If the item does not exist, the returned error matches ErrItemNotFound.
+func (s *ItemsService) GetByID(ctx context.Context, item string) (*Item, *Response, error) {
+	u := fmt.Sprintf("item/%v", item)
+	req, err := s.client.NewRequest(http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(ItemsResponse)
+	resp, err := s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, resp, notFound(err, "item", item)
+	}
+
+	i, err := pickResult(resp, "item", item, len(response.Results), func(i int) string {
+		return response.Results[i].Identifier
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &response.Results[i], resp, nil
+}
+
+// List fetches all items.
+func (s *ItemsService) List(ctx context.Context) ([]Item, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "item")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(ItemsResponse)
+	resp, err := s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Results, resp, nil
+}
+
+// Resolver fetches all items and returns an ItemResolver for them.
+func (s *ItemsService) Resolver(ctx context.Context) (*ItemResolver, *Response, error) {
+	items, resp, err := s.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	return NewItemResolver(items), resp, nil
+}
+
+// ItemCost is implemented by cost entries that reference an item, such as
+// NodeCost and EnhancementCost.
+type ItemCost interface {
+	// ItemIdentifier returns the identifier of the item the cost is paid in.
+	ItemIdentifier() string
+}
+
+// ItemIdentifier returns the identifier of the item the cost is paid in.
+func (c NodeCost) ItemIdentifier() string {
+	return c.Identifier
+}
+
+// ItemIdentifier returns the identifier of the item the cost is paid in.
+func (c EnhancementCost) ItemIdentifier() string {
+	return c.Identifier
+}
+
+// ItemResolver resolves cost entries to the items of a catalog. It is safe
+// for concurrent use.
+type ItemResolver struct {
+	items map[string]*Item
+}
+
+// NewItemResolver returns an ItemResolver for the given items, as returned by
+// ItemsService.List.
+func NewItemResolver(items []Item) *ItemResolver {
+	r := &ItemResolver{items: make(map[string]*Item, len(items))}
+	for i := range items {
+		r.items[items[i].Identifier] = &items[i]
+	}
+	return r
+}
+
+// Resolve returns the item the cost is paid in. The returned bool is false
+// if the item is not in the catalog.
+func (r *ItemResolver) Resolve(cost ItemCost) (*Item, bool) {
+	item, ok := r.items[cost.ItemIdentifier()]
+	return item, ok
+}
@@ -0,0 +1,180 @@
+package e7
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestItemsService_GetByID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/item/ma_fire1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+		{
+			"results": [
+				{
+					"_id": "lesser-flame-rune",
+					"identifier": "ma_fire1",
+					"name": "Lesser Flame Rune",
+					"category": "rune",
+					"grade": 1,
+					"attribute": "fire",
+					"assets": {"icon": "i"}
+				}
+			],
+			"meta": {
+				"requestDate": "date",
+				"apiVersion": "1"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Items.GetByID(ctx, "ma_fire1")
+	if err != nil {
+		t.Errorf("Items.GetByID returned error: %v", err)
+	}
+
+	fire := Fire
+	want := &Item{
+		UUID:       "lesser-flame-rune",
+		Identifier: "ma_fire1",
+		Name:       "Lesser Flame Rune",
+		Category:   Rune,
+		Grade:      1,
+		Attribute:  &fire,
+		Assets:     Assets{Icon: "i"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Items.GetByID mismatch (-want +got):\n%s", diff)
+	}
+
+	// Test s.client.NewRequest failure
+	client.BaseURL.Path = ""
+	got, resp, err := client.Items.GetByID(ctx, "ma_fire1")
+	if got != nil {
+		t.Errorf("client.BaseURL.Path='' GetByID = %#v, want nil", got)
+	}
+	if resp != nil {
+		t.Errorf("client.BaseURL.Path='' GetByID resp = %#v, want nil", resp)
+	}
+	if err == nil {
+		t.Error("client.BaseURL.Path='' GetByID err = nil, want error")
+	}
+}
+
+func TestItemsService_GetByID_notFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/item/x", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, _, err := client.Items.GetByID(context.Background(), "x")
+	if !errors.Is(err, ErrItemNotFound) {
+		t.Errorf("Items.GetByID err = %v, want ErrItemNotFound", err)
+	}
+}
+
+func TestItemsService_List(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/item", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+		{
+			"results": [
+				{
+					"_id": "i",
+					"identifier": "1",
+					"name": "I",
+					"category": "currency"
+				}
+			],
+			"meta": {
+				"requestDate": "date",
+				"apiVersion": "1"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Items.List(ctx)
+	if err != nil {
+		t.Errorf("Items.List returned error: %v", err)
+	}
+
+	want := []Item{
+		{
+			UUID:       "i",
+			Identifier: "1",
+			Name:       "I",
+			Category:   Currency,
+		}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Items.List mismatch (-want +got):\n%s", diff)
+	}
+
+	// Test s.client.NewRequest failure
+	client.BaseURL.Path = ""
+	got, resp, err := client.Items.List(ctx)
+	if got != nil {
+		t.Errorf("client.BaseURL.Path='' List = %#v, want nil", got)
+	}
+	if resp != nil {
+		t.Errorf("client.BaseURL.Path='' List resp = %#v, want nil", resp)
+	}
+	if err == nil {
+		t.Error("client.BaseURL.Path='' List err = nil, want error")
+	}
+}
+
+func TestItemsService_Resolver(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/item", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `
+		{
+			"results": [
+				{"identifier": "ma_fire1", "name": "Lesser Flame Rune", "category": "rune"},
+				{"identifier": "ma_bossmaterial1", "name": "Molagora", "category": "material"}
+			]
+		}`)
+	})
+
+	r, _, err := client.Items.Resolver(context.Background())
+	if err != nil {
+		t.Fatalf("Items.Resolver returned error: %v", err)
+	}
+
+	tests := []struct {
+		cost   ItemCost
+		want   string
+		wantOK bool
+	}{
+		{cost: NodeCost{Identifier: "ma_fire1", Count: 5}, want: "Lesser Flame Rune", wantOK: true},
+		{cost: EnhancementCost{Identifier: "ma_bossmaterial1", Count: 1}, want: "Molagora", wantOK: true},
+		{cost: NodeCost{Identifier: "unknown"}, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := r.Resolve(tt.cost)
+		if ok != tt.wantOK {
+			t.Errorf("Resolve(%q) ok = %v, want %v", tt.cost.ItemIdentifier(), ok, tt.wantOK)
+			continue
+		}
+		if ok && got.Name != tt.want {
+			t.Errorf("Resolve(%q) = %q, want %q", tt.cost.ItemIdentifier(), got.Name, tt.want)
+		}
+	}
+}
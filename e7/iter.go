@@ -0,0 +1,221 @@
+package e7
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// HeroIterator iterates over the heroes of a hero list response, decoding
+// them one at a time as the response body is streamed. It is created by
+// HeroesService.Iter.
+//
+// Successive calls to Next advance the iterator; Hero returns the current
+// hero. Iteration stops at the end of the list, on the first error, or when
+// Close is called. A HeroIterator must be closed if it is not run to
+// completion, or its request is left open.
+type HeroIterator struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	pr     *io.PipeReader
+	dec    *json.Decoder
+	done   chan struct{}
+
+	// resp and doErr are set by the request goroutine before done is
+	// closed.
+	resp  *Response
+	doErr error
+
+	hero      *Hero
+	meta      Metadata
+	started   bool
+	inResults bool
+	finished  bool
+	err       error
+}
+
+// Iter returns an iterator over all heroes. Unlike List, which holds the
+// whole hero list in memory, Iter decodes one hero at a time, so callers can
+// process large responses with little memory and stop early.
+//
+// Strict decoding enabled with WithStrictDecoding does not apply to Iter.
+// With WithCache, the cache reads the whole response before it is decoded,
+// so Iter then holds it in memory like List.
+func (s *HeroesService) Iter(ctx context.Context) *HeroIterator {
+	it := &HeroIterator{ctx: ctx, done: make(chan struct{})}
+	req, err := s.client.NewRequest(http.MethodGet, "hero")
+	if err != nil {
+		it.finished, it.err = true, err
+		close(it.done)
+		return it
+	}
+	if ctx == nil {
+		it.finished, it.err = true, ErrNilContext
+		close(it.done)
+		return it
+	}
+
+	// The request runs on its own context so that stopping the iteration
+	// also aborts a request still waiting for the response.
+	ctx, it.cancel = context.WithCancel(ctx)
+
+	pr, pw := io.Pipe()
+	it.pr, it.dec = pr, json.NewDecoder(pr)
+	go func() {
+		defer close(it.done)
+		it.resp, it.doErr = s.client.Do(ctx, req, pw)
+		if errors.Is(it.doErr, io.ErrClosedPipe) || ctx.Err() != nil && it.ctx.Err() == nil {
+			// The request was stopped by finish, not by a failure.
+			it.doErr = nil
+		}
+		pw.CloseWithError(it.doErr)
+	}()
+	return it
+}
+
+// Next advances the iterator to the next hero, which is then available
+// through Hero. It returns false when there are no more heroes or an error
+// occurred, after which Err reports the error, if any.
+func (it *HeroIterator) Next() bool {
+	if it.finished {
+		return false
+	}
+	ok, err := it.next()
+	if !ok {
+		it.finish(err)
+	}
+	return ok
+}
+
+// next scans the response until the next hero has been decoded or the
+// response ends.
+func (it *HeroIterator) next() (bool, error) {
+	for {
+		if it.inResults {
+			if it.dec.More() {
+				h := new(Hero)
+				if err := it.dec.Decode(h); err != nil {
+					return false, err
+				}
+				it.hero = h
+				return true, nil
+			}
+			// Consume the closing bracket of the results array.
+			if _, err := it.dec.Token(); err != nil {
+				return false, err
+			}
+			it.inResults = false
+		}
+
+		tok, err := it.dec.Token()
+		if err == io.EOF && !it.started {
+			return false, nil // ignore EOF errors caused by empty response body
+		}
+		if err != nil {
+			return false, err
+		}
+		if !it.started {
+			if tok != json.Delim('{') {
+				return false, it.unexpected(tok)
+			}
+			it.started = true
+			continue
+		}
+
+		switch tok {
+		case json.Delim('}'):
+			return false, nil
+		case "results":
+			tok, err := it.dec.Token()
+			if err != nil {
+				return false, err
+			}
+			switch tok {
+			case json.Delim('['):
+				it.inResults = true
+			case nil:
+			default:
+				return false, it.unexpected(tok)
+			}
+		case "meta":
+			if err := it.dec.Decode(&it.meta); err != nil {
+				return false, err
+			}
+		default:
+			var skip json.RawMessage
+			if err := it.dec.Decode(&skip); err != nil {
+				return false, err
+			}
+		}
+	}
+}
+
+func (it *HeroIterator) unexpected(tok json.Token) error {
+	return &DecodeError{
+		Path:   "results",
+		Offset: it.dec.InputOffset(),
+		Err:    fmt.Errorf("unexpected %v in hero list", tok),
+	}
+}
+
+// finish stops the iteration, releases the request and records err.
+func (it *HeroIterator) finish(err error) {
+	it.finished, it.hero = true, nil
+	// Check the caller's context before canceling the request's own.
+	ctxErr := it.ctx.Err()
+	// Unblock the request goroutine if the response has not been read in
+	// full, or not received yet.
+	it.cancel()
+	it.pr.Close()
+	<-it.done
+
+	var decErr *DecodeError
+	switch {
+	case err == nil:
+		// Iteration ended normally, or was stopped by Close; errors of the
+		// aborted request are not reported.
+	case it.doErr != nil:
+		it.err = it.doErr
+	case ctxErr != nil:
+		it.err = ctxErr
+	case errors.As(err, &decErr):
+		it.err = err
+	default:
+		it.err = newDecodeError(err)
+	}
+	if it.resp != nil {
+		it.resp.Metadata = it.meta
+	}
+}
+
+// Hero returns the current hero. It is nil before the first call to Next
+// and after iteration stopped.
+func (it *HeroIterator) Hero() *Hero {
+	return it.hero
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *HeroIterator) Err() error {
+	return it.err
+}
+
+// Response returns the API response of the request. It is only available
+// once iteration stopped.
+func (it *HeroIterator) Response() *Response {
+	if !it.finished {
+		return nil
+	}
+	return it.resp
+}
+
+// Close stops the iteration and releases the underlying request. It is safe
+// to call Close more than once and after iteration stopped.
+func (it *HeroIterator) Close() error {
+	if !it.finished {
+		it.finish(nil)
+	}
+	return nil
+}
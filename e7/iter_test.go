@@ -0,0 +1,273 @@
+package e7
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHeroesService_Iter(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+		{
+			"meta": {
+				"requestDate": "date",
+				"apiVersion": "1"
+			},
+			"extra": {"ignored": [1, 2]},
+			"results": [
+				{"_id": "a", "name": "A"},
+				{"_id": "b", "name": "B"}
+			]
+		}`)
+	})
+
+	it := client.Heroes.Iter(context.Background())
+	defer it.Close()
+
+	var got []Hero
+	for it.Next() {
+		got = append(got, *it.Hero())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("HeroIterator.Err returned error: %v", err)
+	}
+
+	want := []Hero{{UUID: "a", Name: "A"}, {UUID: "b", Name: "B"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("HeroIterator mismatch (-want +got):\n%s", diff)
+	}
+	if it.Hero() != nil {
+		t.Errorf("HeroIterator.Hero after iteration = %v, want nil", it.Hero())
+	}
+
+	resp := it.Response()
+	if resp == nil {
+		t.Fatal("HeroIterator.Response is nil")
+	}
+	if got, want := resp.Metadata.APIVersion, (Version{Major: 1}); got != want {
+		t.Errorf("HeroIterator.Response().Metadata.APIVersion = %v, want %v", got, want)
+	}
+}
+
+func TestHeroesService_Iter_stopEarly(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [`)
+		for i := 0; i < 1000; i++ {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"_id": "h%d"}`, i)
+		}
+		fmt.Fprint(w, `]}`)
+	})
+
+	it := client.Heroes.Iter(context.Background())
+	if !it.Next() {
+		t.Fatalf("HeroIterator.Next = false, want true; err: %v", it.Err())
+	}
+	if got, want := it.Hero().UUID, "h0"; got != want {
+		t.Errorf("HeroIterator.Hero().UUID = %q, want %q", got, want)
+	}
+
+	if err := it.Close(); err != nil {
+		t.Errorf("HeroIterator.Close returned error: %v", err)
+	}
+	if it.Next() {
+		t.Error("HeroIterator.Next after Close = true, want false")
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("HeroIterator.Err after Close = %v, want nil", err)
+	}
+}
+
+func TestHeroesService_Iter_closeBeforeResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	release := make(chan struct{})
+	defer close(release)
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	it := client.Heroes.Iter(context.Background())
+	closed := make(chan struct{})
+	go func() {
+		it.Close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("HeroIterator.Close blocked while the server had not responded")
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("HeroIterator.Err after Close = %v, want nil", err)
+	}
+}
+
+func TestHeroesService_Iter_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr interface{}
+	}{
+		{name: "not found", status: http.StatusNotFound, wantErr: new(*NotFoundError)},
+		{name: "not an object", status: http.StatusOK, body: `[]`, wantErr: new(*DecodeError)},
+		{name: "bad hero", status: http.StatusOK, body: `{"results": [{"rarity": "x"}]}`, wantErr: new(*DecodeError)},
+		{name: "truncated", status: http.StatusOK, body: `{"results": [{"_id": "a"}`, wantErr: new(*DecodeError)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			})
+
+			it := client.Heroes.Iter(context.Background())
+			defer it.Close()
+			for it.Next() {
+			}
+			if err := it.Err(); !errors.As(err, tt.wantErr) {
+				t.Errorf("HeroIterator.Err = %v (%T), want %T", err, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHeroesService_Iter_connectionDropped(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		// Announce more than is sent, so that the client sees the
+		// connection close mid-body.
+		body := `{"results": [{"_id": "a"},`
+		w.Header().Set("Content-Length", fmt.Sprint(len(body)+100))
+		fmt.Fprint(w, body)
+	})
+
+	it := client.Heroes.Iter(context.Background())
+	defer it.Close()
+	for it.Next() {
+	}
+	err := it.Err()
+	var decErr *DecodeError
+	if errors.As(err, &decErr) {
+		t.Errorf("HeroIterator.Err = %v, want the network error, not a *DecodeError", err)
+	}
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("HeroIterator.Err = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestHeroesService_Iter_emptyBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {})
+
+	it := client.Heroes.Iter(context.Background())
+	defer it.Close()
+	if it.Next() {
+		t.Error("HeroIterator.Next = true, want false")
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("HeroIterator.Err = %v, want nil", err)
+	}
+}
+
+func TestHeroesService_Iter_badRequest(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	// Test s.client.NewRequest failure
+	client.BaseURL.Path = ""
+	it := client.Heroes.Iter(context.Background())
+	defer it.Close()
+	if it.Next() {
+		t.Error("client.BaseURL.Path='' Next = true, want false")
+	}
+	if it.Err() == nil {
+		t.Error("client.BaseURL.Path='' Err = nil, want error")
+	}
+	if it.Response() != nil {
+		t.Errorf("client.BaseURL.Path='' Response = %#v, want nil", it.Response())
+	}
+}
+
+// heroListBody returns a hero list response body with n heroes.
+func heroListBody(n int) string {
+	var b strings.Builder
+	b.WriteString(`{"results": [`)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `{"_id": "h%d", "name": "Hero %d", "rarity": 5, "attribute": "fire", "role": "warrior",
+			"skills": [{"buff": [1, 2], "debuff": [3], "description": "%s"}]}`, i, i, strings.Repeat("x", 512))
+	}
+	b.WriteString(`], "meta": {"requestDate": "date", "apiVersion": "1"}}`)
+	return b.String()
+}
+
+func benchmarkHeroes(b *testing.B, consume func(*Client) error) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	body := heroListBody(500)
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := consume(client); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkHeroesService_List(b *testing.B) {
+	benchmarkHeroes(b, func(client *Client) error {
+		heroes, _, err := client.Heroes.List(context.Background())
+		for range heroes {
+		}
+		return err
+	})
+}
+
+func BenchmarkHeroesService_Iter(b *testing.B) {
+	benchmarkHeroes(b, func(client *Client) error {
+		it := client.Heroes.Iter(context.Background())
+		defer it.Close()
+		for it.Next() {
+		}
+		return it.Err()
+	})
+}
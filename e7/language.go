@@ -0,0 +1,80 @@
+package e7
+
+import (
+	"context"
+	"net/http"
+)
+
+// Language represents a language supported by the EpicSevenDB API. The API
+// localizes names, descriptions and stories based on the "lang" query
+// parameter.
+type Language string
+
+// Supported language.
+const (
+	English            Language = "en"
+	Japanese           Language = "jp"
+	Korean             Language = "kr"
+	ChineseSimplified  Language = "zh-CN"
+	ChineseTraditional Language = "zh-TW"
+	German             Language = "de"
+	Spanish            Language = "es"
+	French             Language = "fr"
+	Portuguese         Language = "pt"
+	Thai               Language = "th"
+)
+
+func (l Language) String() string {
+	return string(l)
+}
+
+// WithLanguage sets the default language used for every request made by the
+// client. It can be overridden per call with ContextWithLanguage.
+func WithLanguage(lang Language) ClientOption {
+	return func(c *Client) error {
+		c.Language = lang
+		return nil
+	}
+}
+
+type languageContextKey struct{}
+
+// ContextWithLanguage returns a copy of ctx that carries lang. Requests sent
+// with the returned context use lang instead of the client's default
+// language.
+func ContextWithLanguage(ctx context.Context, lang Language) context.Context {
+	return context.WithValue(ctx, languageContextKey{}, lang)
+}
+
+// LanguageFromContext returns the language stored in ctx by
+// ContextWithLanguage, if any.
+func LanguageFromContext(ctx context.Context) (Language, bool) {
+	lang, ok := ctx.Value(languageContextKey{}).(Language)
+	return lang, ok
+}
+
+// withLanguage returns a copy of req with the "lang" query parameter set,
+// unless it is already present or no language has been configured. The
+// language is part of the URL so that anything keyed on it, such as a
+// response cache, distinguishes between languages.
+func (c *Client) withLanguage(ctx context.Context, req *http.Request) *http.Request {
+	lang := c.Language
+	if l, ok := LanguageFromContext(ctx); ok {
+		lang = l
+	}
+	if lang == "" {
+		return req
+	}
+
+	q := req.URL.Query()
+	if q.Get("lang") != "" {
+		return req
+	}
+	q.Set("lang", string(lang))
+
+	u := *req.URL
+	u.RawQuery = q.Encode()
+	r := req.Clone(ctx)
+	r.URL = &u
+	return r
+}
@@ -0,0 +1,83 @@
+package e7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func testLanguage(t *testing.T, r *http.Request, want Language) {
+	t.Helper()
+	if got := r.URL.Query().Get("lang"); got != string(want) {
+		t.Errorf("request lang: %q, want %q", got, want)
+	}
+}
+
+func TestDo_language(t *testing.T) {
+	tests := []struct {
+		name   string
+		client Language
+		ctx    context.Context
+		urlStr string
+		want   Language
+	}{
+		{name: "none", ctx: context.Background(), urlStr: ".", want: ""},
+		{name: "client default", client: Japanese, ctx: context.Background(), urlStr: ".", want: Japanese},
+		{name: "context override", client: Japanese, ctx: ContextWithLanguage(context.Background(), Korean), urlStr: ".", want: Korean},
+		{name: "explicit query", client: Japanese, ctx: context.Background(), urlStr: "?lang=fr", want: French},
+	}
+
+	for _, tt := range tests {
+		client, mux, _, teardown := setup()
+		client.Language = tt.client
+
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			testLanguage(t, r, tt.want)
+			fmt.Fprint(w, `{}`)
+		})
+
+		req, _ := client.NewRequest(http.MethodGet, tt.urlStr)
+		if _, err := client.Do(tt.ctx, req, nil); err != nil {
+			t.Errorf("%s: Do returned unexpected error: %v", tt.name, err)
+		}
+		if req.URL.Query().Get("lang") != "" && tt.urlStr == "." {
+			t.Errorf("%s: Do modified the caller's request URL to %v", tt.name, req.URL)
+		}
+		teardown()
+	}
+}
+
+func TestWithLanguage(t *testing.T) {
+	c := NewClient(WithLanguage(German))
+	if got, want := c.Language, German; got != want {
+		t.Errorf("NewClient Language is %v, want %v", got, want)
+	}
+}
+
+func TestLanguageFromContext(t *testing.T) {
+	if _, ok := LanguageFromContext(context.Background()); ok {
+		t.Error("LanguageFromContext on empty context returned ok = true")
+	}
+
+	ctx := ContextWithLanguage(context.Background(), Thai)
+	got, ok := LanguageFromContext(ctx)
+	if !ok || got != Thai {
+		t.Errorf("LanguageFromContext = %v, %v, want %v, true", got, ok, Thai)
+	}
+}
+
+func TestHeroesService_GetByID_language(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero/h", func(w http.ResponseWriter, r *http.Request) {
+		testLanguage(t, r, Korean)
+		fmt.Fprint(w, `{"results": [{"_id": "h"}]}`)
+	})
+
+	ctx := ContextWithLanguage(context.Background(), Korean)
+	if _, _, err := client.Heroes.GetByID(ctx, "h"); err != nil {
+		t.Errorf("Heroes.GetByID returned error: %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+package e7
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// LatestService handles communication with the methods of the EpicSevenDB
+// API that return the most recently released content.
+type LatestService service
+
+// Latest represents the most recently released heroes and artifacts.
+type Latest struct {
+	Heroes    []HeroRelease     `json:"heroes,omitempty"`
+	Artifacts []ArtifactRelease `json:"artifacts,omitempty"`
+}
+
+// HeroRelease represents a summary of a recently released hero.
+type HeroRelease struct {
+	UUID        string    `json:"_id,omitempty"`
+	ID          string    `json:"id,omitempty"`
+	Name        string    `json:"name,omitempty"`
+	Moonlight   bool      `json:"moonlight,omitempty"`
+	Rarity      uint      `json:"rarity,omitempty"`
+	Attribute   Attribute `json:"attribute,omitempty"`
+	Role        Role      `json:"role,omitempty"`
+	Assets      Assets    `json:"assets,omitempty"`
+	ReleaseDate Date      `json:"releaseDate"`
+}
+
+// ArtifactRelease represents a summary of a recently released artifact.
+type ArtifactRelease struct {
+	UUID        string `json:"_id,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Rarity      uint   `json:"rarity,omitempty"`
+	Role        *Role  `json:"role,omitempty"`
+	Assets      Assets `json:"assets,omitempty"`
+	ReleaseDate Date   `json:"releaseDate"`
+}
+
+// Date is a date returned by the API, such as a release date. It embeds the
+// parsed time, which is the zero time if the API returned no date or one
+// that cannot be parsed.
+type Date struct {
+	time.Time
+}
+
+// MarshalJSON marshals d as an RFC 3339 quoted JSON string, or null if d is
+// the zero time.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.Format(time.RFC3339))
+}
+
+// UnmarshalJSON unmarshals a quoted JSON string or null to d. Strings that
+// cannot be parsed, and null, leave d as the zero time rather than failing
+// the decoding of the whole response; other JSON values are an error.
+func (d *Date) UnmarshalJSON(b []byte) error {
+	*d = Date{}
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := unmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	d.Time = parseDate(s)
+	return nil
+}
+
+// Diff returns the heroes and artifacts of l that are not in previous, as
+// identified by their UUID. It is intended to be called with the result of
+// an earlier call to LatestService.Get to find what has just been released.
+func (l Latest) Diff(previous Latest) Latest {
+	seen := make(map[string]bool, len(previous.Heroes)+len(previous.Artifacts))
+	for _, h := range previous.Heroes {
+		seen["hero/"+h.UUID] = true
+	}
+	for _, a := range previous.Artifacts {
+		seen["artifact/"+a.UUID] = true
+	}
+
+	var diff Latest
+	for _, h := range l.Heroes {
+		if !seen["hero/"+h.UUID] {
+			diff.Heroes = append(diff.Heroes, h)
+		}
+	}
+	for _, a := range l.Artifacts {
+		if !seen["artifact/"+a.UUID] {
+			diff.Artifacts = append(diff.Artifacts, a)
+		}
+	}
+	return diff
+}
+
+// LatestResponse is an EpicSevenDB API response that contains the most
+// recently released content.
+type LatestResponse struct {
+	Results  []Latest `json:"results,omitempty"`
+	Metadata Metadata `json:"meta,omitempty"`
+}
+
+func (r *LatestResponse) metadata() Metadata {
+	return r.Metadata
+}
+
+// Get fetches the most recently released heroes and artifacts.
+func (s *LatestService) Get(ctx context.Context) (*Latest, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "latest")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(LatestResponse)
+	resp, err := s.client.Do(ctx, req, response)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	latest := new(Latest)
+	for _, l := range response.Results {
+		latest.Heroes = append(latest.Heroes, l.Heroes...)
+		latest.Artifacts = append(latest.Artifacts, l.Artifacts...)
+	}
+	return latest, resp, nil
+}
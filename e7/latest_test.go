@@ -0,0 +1,168 @@
+package e7
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLatestService_Get(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/latest", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `
+		{
+			"results": [
+				{
+					"heroes": [
+						{
+							"_id": "h",
+							"name": "H",
+							"rarity": 5,
+							"attribute": "fire",
+							"role": "mage",
+							"releaseDate": "2020-08-20T00:00:00Z"
+						}
+					],
+					"artifacts": [
+						{
+							"_id": "a",
+							"name": "A",
+							"rarity": 5,
+							"releaseDate": "2020-08-20T00:00:00Z"
+						}
+					]
+				}
+			],
+			"meta": {
+				"requestDate": "date",
+				"apiVersion": "1"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Latest.Get(ctx)
+	if err != nil {
+		t.Errorf("Latest.Get returned error: %v", err)
+	}
+
+	released := Date{time.Date(2020, time.August, 20, 0, 0, 0, 0, time.UTC)}
+	want := &Latest{
+		Heroes: []HeroRelease{
+			{UUID: "h", Name: "H", Rarity: 5, Attribute: Fire, Role: Mage, ReleaseDate: released},
+		},
+		Artifacts: []ArtifactRelease{
+			{UUID: "a", Name: "A", Rarity: 5, ReleaseDate: released},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Latest.Get mismatch (-want +got):\n%s", diff)
+	}
+
+	// Test s.client.NewRequest failure
+	client.BaseURL.Path = ""
+	got, resp, err := client.Latest.Get(ctx)
+	if got != nil {
+		t.Errorf("client.BaseURL.Path='' Get = %#v, want nil", got)
+	}
+	if resp != nil {
+		t.Errorf("client.BaseURL.Path='' Get resp = %#v, want nil", resp)
+	}
+	if err == nil {
+		t.Error("client.BaseURL.Path='' Get err = nil, want error")
+	}
+}
+
+func TestLatest_Diff(t *testing.T) {
+	previous := Latest{
+		Heroes:    []HeroRelease{{UUID: "a"}, {UUID: "b"}},
+		Artifacts: []ArtifactRelease{{UUID: "x"}},
+	}
+	current := Latest{
+		Heroes:    []HeroRelease{{UUID: "c"}, {UUID: "a"}, {UUID: "b"}},
+		Artifacts: []ArtifactRelease{{UUID: "a"}, {UUID: "x"}},
+	}
+
+	want := Latest{
+		Heroes:    []HeroRelease{{UUID: "c"}},
+		Artifacts: []ArtifactRelease{{UUID: "a"}},
+	}
+	if diff := cmp.Diff(want, current.Diff(previous)); diff != "" {
+		t.Errorf("Latest.Diff mismatch (-want +got):\n%s", diff)
+	}
+
+	if got := current.Diff(current); got.Heroes != nil || got.Artifacts != nil {
+		t.Errorf("Latest.Diff with itself = %+v, want empty", got)
+	}
+}
+
+func TestDate_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{in: `"2020-08-20T00:00:00Z"`, want: time.Date(2020, time.August, 20, 0, 0, 0, 0, time.UTC)},
+		{in: `"2020-08-20"`, want: time.Date(2020, time.August, 20, 0, 0, 0, 0, time.UTC)},
+		{in: `"Thu Aug 20 00:00:00 UTC 2020"`, want: time.Date(2020, time.August, 20, 0, 0, 0, 0, time.UTC)},
+		{in: `""`},
+		{in: `"soon"`},
+		{in: `null`},
+	}
+
+	for _, tt := range tests {
+		var got Date
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+			t.Errorf("Date.UnmarshalJSON(%s) returned error: %v", tt.in, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Date.UnmarshalJSON(%s) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{`12`, `true`, `{}`, `["2020-08-20"]`} {
+		var got Date
+		if err := json.Unmarshal([]byte(in), &got); err == nil {
+			t.Errorf("Date.UnmarshalJSON(%s) returned no error", in)
+		}
+	}
+}
+
+func TestDate_MarshalJSON(t *testing.T) {
+	for d, want := range map[Date]string{
+		{}: `null`,
+		{time.Date(2020, time.August, 20, 0, 0, 0, 0, time.UTC)}: `"2020-08-20T00:00:00Z"`,
+	} {
+		b, err := json.Marshal(d)
+		if err != nil {
+			t.Errorf("Date.MarshalJSON returned error: %v", err)
+		}
+		if got := string(b); got != want {
+			t.Errorf("Date.MarshalJSON = %s, want %s", got, want)
+		}
+	}
+}
+
+func TestLatestService_Get_lenientDates(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/latest", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [{"heroes": [{"_id": "h", "releaseDate": ""}], "artifacts": [{"_id": "a"}]}]}`)
+	})
+
+	got, _, err := client.Latest.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Latest.Get returned error: %v", err)
+	}
+	if !got.Heroes[0].ReleaseDate.IsZero() || !got.Artifacts[0].ReleaseDate.IsZero() {
+		t.Errorf("Latest.Get release dates = %v, want zero", got)
+	}
+}
@@ -0,0 +1,51 @@
+package e7
+
+// MaterialCost represents the total amount of an item needed for hero
+// progression, such as awakening or skill enhancement.
+type MaterialCost struct {
+	Identifier string
+	Name       string
+	Category   ItemCategory
+	Grade      uint
+	Attribute  *Attribute
+	Count      int
+}
+
+// materialTotals sums material costs by item identifier, keeping the items
+// in the order they are first added.
+type materialTotals struct {
+	costs []MaterialCost
+	index map[string]int
+}
+
+func (t *materialTotals) add(m MaterialCost) {
+	if i, ok := t.index[m.Identifier]; ok {
+		t.costs[i].Count += m.Count
+		return
+	}
+	if t.index == nil {
+		t.index = make(map[string]int)
+	}
+	t.index[m.Identifier] = len(t.costs)
+	t.costs = append(t.costs, m)
+}
+
+// category returns the costs of items of category c.
+func (t *materialTotals) category(c ItemCategory) []MaterialCost {
+	var costs []MaterialCost
+	for _, m := range t.costs {
+		if m.Category == c {
+			costs = append(costs, m)
+		}
+	}
+	return costs
+}
+
+// sumCounts returns the total count of costs.
+func sumCounts(costs []MaterialCost) int {
+	n := 0
+	for _, m := range costs {
+		n += m.Count
+	}
+	return n
+}
@@ -0,0 +1,159 @@
+package e7
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Metadata is set of metadata that is returned in every EpicSevenDB API
+// response.
+type Metadata struct {
+	// RequestDate is the time at which the API served the request.
+	RequestDate time.Time
+
+	// APIVersion is the version of the API that served the request.
+	APIVersion Version
+}
+
+// dateLayouts are the layouts tried, in order, when parsing dates returned
+// by the API. The requestDate of a response currently uses time.UnixDate.
+var dateLayouts = []string{
+	time.UnixDate,
+	time.RFC3339,
+	time.RFC1123,
+	"2006-01-02",
+}
+
+// parseDate parses s with the first of dateLayouts that matches. It returns
+// the zero time if none does.
+func parseDate(s string) time.Time {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+type rawMetadata struct {
+	RequestDate string `json:"requestDate,omitempty"`
+	APIVersion  string `json:"apiVersion,omitempty"`
+}
+
+// MarshalJSON marshals m to the JSON object used by the API.
+func (m Metadata) MarshalJSON() ([]byte, error) {
+	raw := rawMetadata{}
+	if !m.RequestDate.IsZero() {
+		raw.RequestDate = m.RequestDate.Format(time.UnixDate)
+	}
+	if m.APIVersion != (Version{}) {
+		raw.APIVersion = m.APIVersion.String()
+	}
+	return json.Marshal(raw)
+}
+
+// UnmarshalJSON unmarshals the JSON object used by the API to m. Values that
+// cannot be parsed are left as their zero value rather than failing the
+// decoding of the whole response.
+func (m *Metadata) UnmarshalJSON(b []byte) error {
+	var raw rawMetadata
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	*m = Metadata{RequestDate: parseDate(raw.RequestDate)}
+	if v, err := ParseVersion(raw.APIVersion); err == nil {
+		m.APIVersion = v
+	}
+	return nil
+}
+
+// metadataCarrier is implemented by API response envelopes so that Do can
+// expose their metadata on the returned Response.
+type metadataCarrier interface {
+	metadata() Metadata
+}
+
+// Version represents a semantic version of the EpicSevenDB API. Versions are
+// comparable with == and ordered with Compare.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+// ParseVersion parses a version of the form "major[.minor[.patch]]". A
+// leading "v" is allowed.
+func ParseVersion(s string) (Version, error) {
+	var v Version
+	parts := strings.Split(strings.TrimPrefix(s, "v"), ".")
+	if len(parts) > 3 {
+		return v, fmt.Errorf("e7: invalid version %q", s)
+	}
+	fields := []*int{&v.Major, &v.Minor, &v.Patch}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return Version{}, fmt.Errorf("e7: invalid version %q", s)
+		}
+		*fields[i] = n
+	}
+	return v, nil
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// Compare returns -1, 0 or +1 depending on whether v is less than, equal to
+// or greater than o.
+func (v Version) Compare(o Version) int {
+	switch {
+	case v.Major != o.Major:
+		return compareInts(v.Major, o.Major)
+	case v.Minor != o.Minor:
+		return compareInts(v.Minor, o.Minor)
+	default:
+		return compareInts(v.Patch, o.Patch)
+	}
+}
+
+// Less reports whether v is an earlier version than o.
+func (v Version) Less(o Version) bool {
+	return v.Compare(o) < 0
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// MarshalJSON marshals v as a quoted JSON string.
+func (v Version) MarshalJSON() ([]byte, error) {
+	buf := writeStringBuffer(v.String())
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON unmarshals a quoted JSON string to v.
+func (v *Version) UnmarshalJSON(b []byte) error {
+	s, err := unmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+
+	val, err := ParseVersion(s)
+	if err != nil {
+		return err
+	}
+	*v = val
+	return nil
+}
@@ -0,0 +1,126 @@
+package e7
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMetadata_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Metadata
+	}{
+		{
+			in: `{"requestDate": "Sat Aug 22 00:54:50 UTC 2020", "apiVersion": "2.1.0"}`,
+			want: Metadata{
+				RequestDate: time.Date(2020, time.August, 22, 0, 54, 50, 0, time.UTC),
+				APIVersion:  Version{Major: 2, Minor: 1, Patch: 0},
+			},
+		},
+		{
+			in: `{"requestDate": "2020-08-22T00:54:50Z", "apiVersion": "2"}`,
+			want: Metadata{
+				RequestDate: time.Date(2020, time.August, 22, 0, 54, 50, 0, time.UTC),
+				APIVersion:  Version{Major: 2},
+			},
+		},
+		{
+			in:   `{"requestDate": "date", "apiVersion": "unknown"}`,
+			want: Metadata{},
+		},
+	}
+
+	for _, tt := range tests {
+		got := new(Metadata)
+		if err := json.Unmarshal([]byte(tt.in), got); err != nil {
+			t.Errorf("Metadata.UnmarshalJSON(%s) returned error: %v", tt.in, err)
+		}
+		if diff := cmp.Diff(tt.want, *got); diff != "" {
+			t.Errorf("Metadata.UnmarshalJSON(%s) mismatch (-want +got):\n%s", tt.in, diff)
+		}
+	}
+}
+
+func TestMetadata_MarshalJSON(t *testing.T) {
+	m := Metadata{
+		RequestDate: time.Date(2020, time.August, 22, 0, 54, 50, 0, time.UTC),
+		APIVersion:  Version{Major: 2, Minor: 1, Patch: 0},
+	}
+
+	got, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Metadata.MarshalJSON returned error: %v", err)
+	}
+	want := `{"requestDate":"Sat Aug 22 00:54:50 UTC 2020","apiVersion":"2.1.0"}`
+	if diff := cmp.Diff(want, string(got)); diff != "" {
+		t.Errorf("Metadata.MarshalJSON mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Version
+		wantErr bool
+	}{
+		{in: "2.1.0", want: Version{Major: 2, Minor: 1, Patch: 0}},
+		{in: "v1.12.3", want: Version{Major: 1, Minor: 12, Patch: 3}},
+		{in: "3", want: Version{Major: 3}},
+		{in: "", wantErr: true},
+		{in: "1.2.3.4", wantErr: true},
+		{in: "1.x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseVersion(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseVersion(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("ParseVersion(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	tests := []struct {
+		a, b Version
+		want int
+	}{
+		{a: Version{2, 1, 0}, b: Version{2, 1, 0}, want: 0},
+		{a: Version{2, 1, 0}, b: Version{2, 0, 9}, want: 1},
+		{a: Version{1, 9, 9}, b: Version{2, 0, 0}, want: -1},
+		{a: Version{2, 1, 1}, b: Version{2, 1, 2}, want: -1},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("%v.Compare(%v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got, want := tt.a.Less(tt.b), tt.want < 0; got != want {
+			t.Errorf("%v.Less(%v) = %v, want %v", tt.a, tt.b, got, want)
+		}
+	}
+}
+
+func TestVersion_JSON(t *testing.T) {
+	v := Version{Major: 2, Minor: 1, Patch: 0}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Version.MarshalJSON returned error: %v", err)
+	}
+	if got, want := string(b), `"2.1.0"`; got != want {
+		t.Errorf("Version.MarshalJSON = %s, want %s", got, want)
+	}
+
+	var got Version
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Version.UnmarshalJSON returned error: %v", err)
+	}
+	if got != v {
+		t.Errorf("Version.UnmarshalJSON = %v, want %v", got, v)
+	}
+}
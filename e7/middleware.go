@@ -0,0 +1,36 @@
+package e7
+
+import (
+	"context"
+	"net/http"
+)
+
+// A DoFunc sends an API request and stores the decoded response in v, like
+// Client.Do.
+type DoFunc func(ctx context.Context, req *http.Request, v interface{}) (*Response, error)
+
+// Middleware wraps a DoFunc to observe or modify requests and responses.
+// A middleware sees the *http.Request before it is sent and the Response and
+// error, including decoding errors, after it has been handled. It may return
+// without calling next to short-circuit the request with a synthetic
+// Response, in which case it is responsible for populating v.
+//
+// e.g. a middleware that sets a request ID:
+//
+//	func requestID(next e7.DoFunc) e7.DoFunc {
+//		return func(ctx context.Context, req *http.Request, v interface{}) (*e7.Response, error) {
+//			req.Header.Set("X-Request-ID", newID())
+//			return next(ctx, req, v)
+//		}
+//	}
+type Middleware func(next DoFunc) DoFunc
+
+// WithMiddleware appends middleware to the client. Middleware is applied in
+// the order it is given: the first middleware is the outermost and sees the
+// request first and the response last.
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *Client) error {
+		c.middleware = append(c.middleware, mw...)
+		return nil
+	}
+}
@@ -0,0 +1,127 @@
+package e7
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func recordingMiddleware(name string, events *[]string) Middleware {
+	return func(next DoFunc) DoFunc {
+		return func(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+			*events = append(*events, name+" before")
+			resp, err := next(ctx, req, v)
+			*events = append(*events, name+" after")
+			return resp, err
+		}
+	}
+}
+
+func TestDo_middlewareOrder(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var events []string
+	WithMiddleware(recordingMiddleware("a", &events), recordingMiddleware("b", &events))(client)
+	WithMiddleware(recordingMiddleware("c", &events))(client)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		events = append(events, "server")
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := client.NewRequest(http.MethodGet, ".")
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	want := []string{"a before", "b before", "c before", "server", "c after", "b after", "a after"}
+	if diff := cmp.Diff(want, events); diff != "" {
+		t.Errorf("middleware events mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDo_middlewareRequestAndError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var gotErr error
+	WithMiddleware(func(next DoFunc) DoFunc {
+		return func(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+			req.Header.Set("Authorization", "token t")
+			resp, err := next(ctx, req, v)
+			gotErr = err
+			return resp, err
+		}
+	})(client)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Get("Authorization"), "token t"; got != want {
+			t.Errorf("Authorization header is %q, want %q", got, want)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	req, _ := client.NewRequest(http.MethodGet, ".")
+	_, err := client.Do(context.Background(), req, nil)
+	if !errors.Is(gotErr, ErrNotFound) || gotErr != err {
+		t.Errorf("middleware saw error %v, want the returned not found error %v", gotErr, err)
+	}
+}
+
+func TestDo_middlewareShortCircuit(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithMiddleware(func(next DoFunc) DoFunc {
+		return func(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+			v.(*HeroesResponse).Results = []Hero{{UUID: "cached"}}
+			return &Response{
+				Response:  &http.Response{StatusCode: http.StatusOK, Request: req},
+				FromCache: true,
+			}, nil
+		}
+	})(client)
+
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request was sent despite short-circuiting middleware")
+	})
+
+	got, resp, err := client.Heroes.List(context.Background())
+	if err != nil {
+		t.Fatalf("Heroes.List returned error: %v", err)
+	}
+	if want := []Hero{{UUID: "cached"}}; !cmp.Equal(want, got) {
+		t.Errorf("Heroes.List mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+	if !resp.FromCache {
+		t.Error("Response.FromCache = false, want synthetic response")
+	}
+}
+
+func TestMiddleware_syntheticEmptyResponse(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	for _, resp := range []*Response{nil, {}} {
+		resp := resp
+		client.middleware = nil
+		WithMiddleware(func(next DoFunc) DoFunc {
+			return func(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+				return resp, nil
+			}
+		})(client)
+
+		_, _, err := client.Heroes.GetByID(context.Background(), "h")
+		if !errors.Is(err, ErrHeroNotFound) {
+			t.Fatalf("Heroes.GetByID err = %v, want ErrHeroNotFound", err)
+		}
+		if msg := err.Error(); msg == "" {
+			t.Error("Heroes.GetByID err.Error() is empty")
+		}
+	}
+}
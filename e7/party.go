@@ -0,0 +1,111 @@
+package e7
+
+import "fmt"
+
+// PartySize is the number of heroes in a party.
+const PartySize = 4
+
+// Has reports whether slot, from 1 to PartySize, is one of s.
+func (s Slots) Has(slot int) bool {
+	switch slot {
+	case 1:
+		return s.One
+	case 2:
+		return s.Two
+	case 3:
+		return s.Three
+	case 4:
+		return s.Four
+	default:
+		return false
+	}
+}
+
+// PartyMember represents a hero in a party.
+type PartyMember struct {
+	Hero *Hero
+
+	// Imprint is the hero's imprint grade, or "" if the hero's devotion is
+	// not unlocked.
+	Imprint DevotionGrade
+}
+
+// Party represents a party of heroes. The member at index i is in slot i+1.
+// A member with a nil Hero is an empty slot.
+type Party [PartySize]PartyMember
+
+// DevotionContribution is the stat a party member's devotion grants to the
+// hero of a slot.
+type DevotionContribution struct {
+	// From and To are the slots, from 1 to PartySize, of the hero whose
+	// devotion applies and of the hero receiving it.
+	From, To int
+
+	// Hero is the hero whose devotion applies.
+	Hero *Hero
+
+	Bonus StatBonus
+}
+
+func (c DevotionContribution) String() string {
+	return fmt.Sprintf("%v (slot %d) grants slot %d %v %+g", c.Hero.Name, c.From, c.To, c.Bonus.Stat, c.Bonus.Value)
+}
+
+// PartyDevotion is the result of aggregating the devotion of a party.
+type PartyDevotion struct {
+	// Slots are the stats every slot receives, with bonuses to the same
+	// stat summed. Slots[i] is for slot i+1, and can be used as
+	// Build.Devotion.
+	Slots [PartySize][]StatBonus
+
+	// Contributions lists every devotion applied, ordered by contributing
+	// slot, then receiving slot.
+	Contributions []DevotionContribution
+}
+
+// Devotion computes the stats every hero of p receives from the devotion
+// (imprint) of the party's heroes. A hero's devotion applies to the heroes
+// in the slots of its Devotion.Slots, including its own slot, at the
+// hero's imprint grade. Empty slots receive nothing.
+//
+// If a member has an imprint grade that is not a known grade, the returned
+// error is ErrUnknownDevotionGrade.
+func (p Party) Devotion() (*PartyDevotion, error) {
+	d := new(PartyDevotion)
+	for i, from := range p {
+		if from.Hero == nil || from.Imprint == "" {
+			continue
+		}
+		value, ok := from.Hero.Devotion.Grades.Value(from.Imprint)
+		if !ok {
+			return nil, ErrUnknownDevotionGrade
+		}
+		bonus := StatBonus{Stat: from.Hero.Devotion.Type, Value: float64(value)}
+
+		for j, to := range p {
+			if to.Hero == nil || !from.Hero.Devotion.Slots.Has(j+1) {
+				continue
+			}
+			d.Contributions = append(d.Contributions, DevotionContribution{
+				From:  i + 1,
+				To:    j + 1,
+				Hero:  from.Hero,
+				Bonus: bonus,
+			})
+			d.Slots[j] = addStatBonus(d.Slots[j], bonus)
+		}
+	}
+	return d, nil
+}
+
+// addStatBonus adds bonus to bonuses, summing it with a bonus to the same
+// stat if there is one.
+func addStatBonus(bonuses []StatBonus, bonus StatBonus) []StatBonus {
+	for i := range bonuses {
+		if bonuses[i].Stat == bonus.Stat {
+			bonuses[i].Value += bonus.Value
+			return bonuses
+		}
+	}
+	return append(bonuses, bonus)
+}
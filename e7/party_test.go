@@ -0,0 +1,97 @@
+package e7
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSlots_Has(t *testing.T) {
+	s := Slots{One: true, Three: true}
+	for slot, want := range map[int]bool{0: false, 1: true, 2: false, 3: true, 4: false, 5: false} {
+		if got := s.Has(slot); got != want {
+			t.Errorf("Slots.Has(%d) = %v, want %v", slot, got, want)
+		}
+	}
+}
+
+func TestParty_Devotion(t *testing.T) {
+	grades := DevotionGrades{B: 0.036, A: 0.054, S: 0.072, SS: 0.09, SSS: 0.108}
+	aramintha := &Hero{Name: "Aramintha", Devotion: Devotion{Type: AttackPercent, Grades: grades, Slots: Slots{One: true, Two: true, Three: true, Four: true}}}
+	tank := &Hero{Name: "Tank", Devotion: Devotion{Type: HealthPercent, Grades: grades, Slots: Slots{Two: true, Four: true}}}
+	striker := &Hero{Name: "Striker", Devotion: Devotion{Type: AttackPercent, Grades: grades, Slots: Slots{One: true}}}
+
+	p := Party{
+		{Hero: striker, Imprint: GradeB},
+		{Hero: aramintha, Imprint: GradeS},
+		{Hero: tank, Imprint: GradeA},
+		{}, // empty slot
+	}
+	got, err := p.Devotion()
+	if err != nil {
+		t.Fatalf("Party.Devotion returned error: %v", err)
+	}
+
+	wantSlots := [PartySize][]StatBonus{
+		{{Stat: AttackPercent, Value: float64(float32(0.036)) + float64(float32(0.072))}},
+		{{Stat: AttackPercent, Value: float64(float32(0.072))}, {Stat: HealthPercent, Value: float64(float32(0.054))}},
+		{{Stat: AttackPercent, Value: float64(float32(0.072))}},
+		nil,
+	}
+	if diff := cmp.Diff(wantSlots, got.Slots); diff != "" {
+		t.Errorf("Party.Devotion slots mismatch (-want +got):\n%s", diff)
+	}
+
+	var explained []string
+	for _, c := range got.Contributions {
+		explained = append(explained, c.String())
+	}
+	wantExplained := []string{
+		"Striker (slot 1) grants slot 1 att_rate +0.035999998450279236",
+		"Aramintha (slot 2) grants slot 1 att_rate +0.07199999690055847",
+		"Aramintha (slot 2) grants slot 2 att_rate +0.07199999690055847",
+		"Aramintha (slot 2) grants slot 3 att_rate +0.07199999690055847",
+		"Tank (slot 3) grants slot 2 max_hp_rate +0.05400000140070915",
+	}
+	if diff := cmp.Diff(wantExplained, explained); diff != "" {
+		t.Errorf("Party.Devotion contributions mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParty_Devotion_noImprint(t *testing.T) {
+	h := &Hero{Devotion: Devotion{Type: Speed, Grades: DevotionGrades{B: 1}, Slots: Slots{One: true}}}
+
+	got, err := Party{{Hero: h}}.Devotion()
+	if err != nil {
+		t.Fatalf("Party.Devotion returned error: %v", err)
+	}
+	if len(got.Contributions) != 0 || got.Slots[0] != nil {
+		t.Errorf("Party.Devotion = %+v, want no devotion", got)
+	}
+
+	if _, err := (Party{{Hero: h, Imprint: "Z"}}).Devotion(); !errors.Is(err, ErrUnknownDevotionGrade) {
+		t.Errorf("Party.Devotion err = %v, want ErrUnknownDevotionGrade", err)
+	}
+}
+
+func TestParty_Devotion_finalStats(t *testing.T) {
+	h := finalStatsTestHero()
+	h.Name = "H"
+	h.Devotion = Devotion{Type: AttackPercent, Grades: DevotionGrades{SSS: 0.1}, Slots: Slots{One: true}}
+
+	d, err := Party{{Hero: h, Imprint: GradeSSS}}.Devotion()
+	if err != nil {
+		t.Fatalf("Party.Devotion returned error: %v", err)
+	}
+	got, err := h.FinalStats(Build{State: Level60SixStarFullyAwakened, Devotion: d.Slots[0]})
+	if err != nil {
+		t.Fatalf("Hero.FinalStats returned error: %v", err)
+	}
+	if got, want := got.Total.Attack, uint(1100); got != want {
+		t.Errorf("Hero.FinalStats Attack = %d, want %d", got, want)
+	}
+	if src := got.Breakdown[len(got.Breakdown)-1].Source; src != SourceDevotion {
+		t.Errorf("Hero.FinalStats last source = %v, want %v", src, SourceDevotion)
+	}
+}
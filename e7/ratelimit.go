@@ -0,0 +1,167 @@
+package e7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// A Limiter controls how frequently the client sends requests. Wait blocks
+// until a request may be sent, or returns an error if ctx is done first.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// WithLimiter sets the limiter that Client.Do waits on before every attempt,
+// including retries.
+func WithLimiter(l Limiter) ClientOption {
+	return func(c *Client) error {
+		c.limiter = l
+		return nil
+	}
+}
+
+// TokenBucket is a Limiter implementing the token bucket algorithm. Each
+// request takes a token; tokens are refilled at a fixed rate up to a maximum
+// burst size. It is safe for concurrent use.
+type TokenBucket struct {
+	interval time.Duration
+	burst    int
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket returns a full TokenBucket that refills one token every
+// interval and holds at most burst tokens.
+//
+// e.g. NewTokenBucket(time.Second/5, 10) allows 5 requests per second with
+// bursts of 10.
+func NewTokenBucket(interval time.Duration, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		interval: interval,
+		burst:    burst,
+		tokens:   float64(burst),
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until a token is available and takes it. If ctx is done before
+// then, ctx.Err() is returned and no token is taken.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		d := b.reserve(time.Now())
+		if d == 0 {
+			return nil
+		}
+		if err := sleep(ctx, d); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve takes a token if one is available at now and returns 0, or returns
+// how long to wait until one becomes available.
+func (b *TokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.interval <= 0 {
+		return 0
+	}
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += float64(elapsed) / float64(b.interval)
+		if max := float64(b.burst); b.tokens > max {
+			b.tokens = max
+		}
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	d := time.Duration((1 - b.tokens) * float64(b.interval))
+	if d <= 0 {
+		d = time.Nanosecond
+	}
+	return d
+}
+
+// Rate represents the rate limit state reported by the EpicSevenDB API in
+// the response headers of a request.
+type Rate struct {
+	// The number of requests per window the client is currently limited to.
+	Limit int `json:"limit"`
+
+	// The number of remaining requests the client can make this window.
+	Remaining int `json:"remaining"`
+
+	// The time at which the current rate limit window will reset.
+	Reset time.Time `json:"reset"`
+}
+
+func (r Rate) String() string {
+	return fmt.Sprintf("%d/%d, resets at %v", r.Remaining, r.Limit, r.Reset)
+}
+
+// parseRate parses the rate limit headers of r. Both the conventional
+// X-RateLimit-* headers and the unprefixed RateLimit-* headers are
+// understood. Reset is either a Unix timestamp or a number of seconds from
+// now. The returned bool is false if r has no rate limit headers.
+func parseRate(r *http.Response, now time.Time) (Rate, bool) {
+	var rate Rate
+	found := false
+	header := func(name string) string {
+		if v := r.Header.Get("X-RateLimit-" + name); v != "" {
+			return v
+		}
+		return r.Header.Get("RateLimit-" + name)
+	}
+
+	if limit, err := strconv.Atoi(header("Limit")); err == nil {
+		rate.Limit = limit
+		found = true
+	}
+	if remaining, err := strconv.Atoi(header("Remaining")); err == nil {
+		rate.Remaining = remaining
+		found = true
+	}
+	if reset, err := strconv.ParseInt(header("Reset"), 10, 64); err == nil {
+		// Values this large can only be Unix timestamps.
+		if reset > 1e9 {
+			rate.Reset = time.Unix(reset, 0)
+		} else {
+			rate.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+		found = true
+	}
+	return rate, found
+}
+
+// RateLimit returns the rate limit state reported by the most recent API
+// response that carried rate limit headers. The returned bool is false if no
+// such response has been received yet.
+func (c *Client) RateLimit() (Rate, bool) {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	return c.rate, c.hasRate
+}
+
+// updateRate records the rate limit state reported by resp, if any.
+func (c *Client) updateRate(resp *http.Response) {
+	rate, ok := parseRate(resp, time.Now())
+	if !ok {
+		return
+	}
+	c.rateMu.Lock()
+	c.rate, c.hasRate = rate, true
+	c.rateMu.Unlock()
+}
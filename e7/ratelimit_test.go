@@ -0,0 +1,172 @@
+package e7
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type countingLimiter struct {
+	calls int
+	err   error
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.calls++
+	return l.err
+}
+
+func TestDo_limiter(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	limiter := new(countingLimiter)
+	client.limiter = limiter
+	client.retry = testRetryPolicy
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			http.Error(w, "Bad Gateway", http.StatusBadGateway)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := client.NewRequest(http.MethodGet, ".")
+	if _, err := client.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	if limiter.calls != 2 {
+		t.Errorf("limiter was waited on %d times, want 2", limiter.calls)
+	}
+}
+
+func TestDo_limiterError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.limiter = &countingLimiter{err: context.Canceled}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request was sent despite limiter error")
+	})
+
+	req, _ := client.NewRequest(http.MethodGet, ".")
+	if _, err := client.Do(context.Background(), req, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected a Canceled error, got: %#v", err)
+	}
+}
+
+func TestDo_rateLimitHeaders(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	if _, ok := client.RateLimit(); ok {
+		t.Error("RateLimit returned ok = true before any request")
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "59")
+		w.Header().Set("X-RateLimit-Reset", "1598057690")
+		fmt.Fprint(w, `{}`)
+	})
+
+	req, _ := client.NewRequest(http.MethodGet, ".")
+	resp, err := client.Do(context.Background(), req, nil)
+	if err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+
+	want := Rate{Limit: 60, Remaining: 59, Reset: time.Unix(1598057690, 0)}
+	if diff := cmp.Diff(want, resp.Rate); diff != "" {
+		t.Errorf("Response.Rate mismatch (-want +got):\n%s", diff)
+	}
+
+	got, ok := client.RateLimit()
+	if !ok {
+		t.Fatal("RateLimit returned ok = false after a response with rate limit headers")
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("RateLimit mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	now := time.Date(2020, time.August, 22, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		header http.Header
+		want   Rate
+		wantOK bool
+	}{
+		{header: http.Header{}, wantOK: false},
+		{
+			header: http.Header{"Ratelimit-Limit": {"10"}, "Ratelimit-Remaining": {"0"}, "Ratelimit-Reset": {"30"}},
+			want:   Rate{Limit: 10, Remaining: 0, Reset: now.Add(30 * time.Second)},
+			wantOK: true,
+		},
+		{
+			header: http.Header{"X-Ratelimit-Remaining": {"5"}},
+			want:   Rate{Remaining: 5},
+			wantOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseRate(&http.Response{Header: tt.header}, now)
+		if ok != tt.wantOK {
+			t.Errorf("parseRate(%v) ok = %v, want %v", tt.header, ok, tt.wantOK)
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("parseRate(%v) mismatch (-want +got):\n%s", tt.header, diff)
+		}
+	}
+}
+
+func TestTokenBucket_reserve(t *testing.T) {
+	b := NewTokenBucket(time.Second, 2)
+	now := b.last
+
+	for i := 0; i < 2; i++ {
+		if d := b.reserve(now); d != 0 {
+			t.Errorf("reserve #%d = %v, want 0 while burst is available", i, d)
+		}
+	}
+	if d := b.reserve(now); d != time.Second {
+		t.Errorf("reserve on empty bucket = %v, want %v", d, time.Second)
+	}
+	if d := b.reserve(now.Add(500 * time.Millisecond)); d != 500*time.Millisecond {
+		t.Errorf("reserve on half refilled bucket = %v, want %v", d, 500*time.Millisecond)
+	}
+	if d := b.reserve(now.Add(time.Second)); d != 0 {
+		t.Errorf("reserve on refilled bucket = %v, want 0", d)
+	}
+}
+
+func TestTokenBucket_Wait(t *testing.T) {
+	b := NewTokenBucket(time.Hour, 1)
+	ctx := context.Background()
+	if err := b.Wait(ctx); err != nil {
+		t.Fatalf("Wait returned unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Millisecond)
+	defer cancel()
+	if err := b.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded error, got: %#v", err)
+	}
+}
+
+func TestWithLimiter(t *testing.T) {
+	l := NewTokenBucket(time.Second, 1)
+	c := NewClient(WithLimiter(l))
+	if c.limiter != l {
+		t.Errorf("NewClient limiter is %v, want %v", c.limiter, l)
+	}
+}
@@ -0,0 +1,167 @@
+package e7
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy configures how Client.Do retries failed requests. Network
+// errors, 429 Too Many Requests and 5xx responses (other than 501 Not
+// Implemented) are retried with exponential backoff and jitter. A Retry-After
+// header, or an exhausted rate limit with a reset time, sent by the server
+// takes precedence over the computed backoff.
+//
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values less than 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry. It doubles with every
+	// subsequent retry.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the computed backoff. If zero, the backoff is capped
+	// at 10 seconds. It does not cap delays requested by the server through
+	// Retry-After.
+	MaxBackoff time.Duration
+}
+
+// defaultMaxBackoff caps the computed backoff of a RetryPolicy without
+// MaxBackoff.
+const defaultMaxBackoff = 10 * time.Second
+
+// DefaultRetryPolicy is a reasonable RetryPolicy for most applications.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  defaultMaxBackoff,
+}
+
+// WithRetryPolicy sets the policy used to retry failed requests.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		if p.MinBackoff < 0 || p.MaxBackoff < 0 {
+			return fmt.Errorf("e7: retry backoff must not be negative, got %v and %v", p.MinBackoff, p.MaxBackoff)
+		}
+		c.retry = p
+		return nil
+	}
+}
+
+// RetryError is returned by Client.Do when a request failed after being
+// attempted more than once. It wraps the error of the last attempt.
+type RetryError struct {
+	// Attempts is the number of attempts made.
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("giving up after %d attempts: %v", e.Attempts, e.Err)
+}
+
+// Unwrap returns the error of the last attempt.
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// next reports whether a request that failed on the given attempt with resp
+// and err should be retried, and how long to wait before doing so.
+func (p RetryPolicy) next(ctx context.Context, req *http.Request, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return 0, false
+	}
+	if req.Body != nil && req.GetBody == nil {
+		// The body has been consumed and cannot be replayed.
+		return 0, false
+	}
+	if err != nil && resp == nil {
+		return p.backoff(attempt), true
+	}
+	if resp == nil || !retryableStatus(resp.StatusCode) {
+		return 0, false
+	}
+	now := time.Now()
+	if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+		return d, true
+	}
+	if rate, ok := parseRate(resp, now); ok && rate.Remaining == 0 && rate.Reset.After(now) {
+		return rate.Reset.Sub(now), true
+	}
+	return p.backoff(attempt), true
+}
+
+// backoff returns the delay before the retry following the given attempt.
+// Half of the exponential delay is fixed and the other half is random.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	limit := p.MaxBackoff
+	if limit <= 0 {
+		limit = defaultMaxBackoff
+	}
+	d := p.MinBackoff
+	for i := 1; i < attempt && d < limit; i++ {
+		if d > limit/2 {
+			// Doubling would pass the cap, or overflow.
+			d = limit
+			break
+		}
+		d *= 2
+	}
+	if d > limit {
+		d = limit
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+func retryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests ||
+		(code >= 500 && code != http.StatusNotImplemented)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either
+// a number of seconds or an HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
+// sleep waits for d or until ctx is done, whichever happens first.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
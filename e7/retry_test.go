@@ -0,0 +1,221 @@
+package e7
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  2 * time.Millisecond,
+}
+
+func TestDo_retry(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.retry = testRetryPolicy
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	req, _ := client.NewRequest(http.MethodGet, ".")
+	body := new(struct{ A string })
+	if _, err := client.Do(context.Background(), req, body); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("server was called %d times, want 3", calls)
+	}
+	if body.A != "a" {
+		t.Errorf("response body was %q, want %q", body.A, "a")
+	}
+}
+
+func TestDo_retryExhausted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.retry = testRetryPolicy
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+	})
+
+	req, _ := client.NewRequest(http.MethodGet, ".")
+	resp, err := client.Do(context.Background(), req, nil)
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) {
+		t.Fatalf("expected *RetryError, got: %#v", err)
+	}
+	if retryErr.Attempts != 3 || calls != 3 {
+		t.Errorf("RetryError.Attempts = %d with %d calls, want 3", retryErr.Attempts, calls)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Errorf("expected RetryError to wrap *ErrorResponse, got: %#v", retryErr.Err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected last HTTP 429 response, got %v", resp)
+	}
+}
+
+func TestDo_retryNotRetryable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.retry = testRetryPolicy
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Not Found", http.StatusNotFound)
+	})
+
+	req, _ := client.NewRequest(http.MethodGet, ".")
+	_, err := client.Do(context.Background(), req, nil)
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Errorf("expected *NotFoundError, got: %#v", err)
+	}
+	if calls != 1 {
+		t.Errorf("server was called %d times, want 1", calls)
+	}
+}
+
+func TestDo_retryContextCanceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.retry = RetryPolicy{MaxAttempts: 5, MinBackoff: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	})
+
+	req, _ := client.NewRequest(http.MethodGet, ".")
+	_, err := client.Do(ctx, req, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected a Canceled error, got: %#v", err)
+	}
+}
+
+func TestDo_retryAfter(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+	client.retry = RetryPolicy{MaxAttempts: 2, MinBackoff: time.Hour}
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, _ := client.NewRequest(http.MethodGet, ".")
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		t.Fatalf("Do returned unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("server was called %d times, want 2", calls)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{attempt: 1, min: 50 * time.Millisecond, max: 100 * time.Millisecond},
+		{attempt: 2, min: 100 * time.Millisecond, max: 200 * time.Millisecond},
+		{attempt: 3, min: 200 * time.Millisecond, max: 400 * time.Millisecond},
+		{attempt: 10, min: 500 * time.Millisecond, max: time.Second},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 20; i++ {
+			if got := p.backoff(tt.attempt); got < tt.min || got > tt.max {
+				t.Errorf("backoff(%d) = %v, want between %v and %v", tt.attempt, got, tt.min, tt.max)
+			}
+		}
+	}
+}
+
+func TestRetryPolicy_backoff_defaultMax(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 100, MinBackoff: 500 * time.Millisecond}
+
+	for _, attempt := range []int{10, 30, 36, 64, 99} {
+		if got := p.backoff(attempt); got < defaultMaxBackoff/2 || got > defaultMaxBackoff {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", attempt, got, defaultMaxBackoff/2, defaultMaxBackoff)
+		}
+	}
+}
+
+func TestRetryPolicy_backoff_overflow(t *testing.T) {
+	limit := time.Duration(math.MaxInt64)
+	p := RetryPolicy{MaxAttempts: 100, MinBackoff: time.Second, MaxBackoff: limit}
+
+	for _, attempt := range []int{40, 63, 64, 99} {
+		if got := p.backoff(attempt); got < limit/2 {
+			t.Errorf("backoff(%d) = %v, want at least %v", attempt, got, limit/2)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2020, time.August, 22, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		in     string
+		want   time.Duration
+		wantOK bool
+	}{
+		{in: "", wantOK: false},
+		{in: "120", want: 2 * time.Minute, wantOK: true},
+		{in: "-1", wantOK: false},
+		{in: "Sat, 22 Aug 2020 00:00:30 GMT", want: 30 * time.Second, wantOK: true},
+		{in: "Fri, 21 Aug 2020 00:00:30 GMT", want: 0, wantOK: true},
+		{in: "soon", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseRetryAfter(tt.in, now)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("parseRetryAfter(%q) = %v, %v, want %v, %v", tt.in, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	c := NewClient(WithRetryPolicy(DefaultRetryPolicy))
+	if c.retry != DefaultRetryPolicy {
+		t.Errorf("NewClient retry policy is %+v, want %+v", c.retry, DefaultRetryPolicy)
+	}
+
+	c = NewClient(WithRetryPolicy(RetryPolicy{MinBackoff: -1}))
+	if _, err := c.NewRequest(http.MethodGet, "."); err == nil {
+		t.Error("NewRequest err = nil, want error for negative backoff")
+	}
+}
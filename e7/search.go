@@ -0,0 +1,246 @@
+package e7
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// SearchService handles communication with the search related methods of
+// the EpicSevenDB API.
+type SearchService service
+
+// SearchKind represents the kind of entity a search result refers to.
+type SearchKind int
+
+// Search result kind.
+const (
+	HeroResult SearchKind = iota
+	ArtifactResult
+	ItemResult
+)
+
+var searchKindStrings = map[SearchKind]string{
+	HeroResult:     "hero",
+	ArtifactResult: "artifact",
+	ItemResult:     "item",
+}
+
+var searchKinds = map[string]SearchKind{
+	"hero":     HeroResult,
+	"artifact": ArtifactResult,
+	"item":     ItemResult,
+}
+
+func (k SearchKind) String() string {
+	return searchKindStrings[k]
+}
+
+// MarshalJSON marshals k as a quoted JSON string.
+func (k SearchKind) MarshalJSON() ([]byte, error) {
+	buf := writeStringBuffer(searchKindStrings[k])
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON unmarshals a quoted JSON string to k.
+func (k *SearchKind) UnmarshalJSON(b []byte) error {
+	s, err := unmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+
+	val, ok := searchKinds[s]
+	if !ok {
+		return ErrUnknownSearchKind
+	}
+	*k = val
+	return nil
+}
+
+// ErrUnknownSearchKind is returned when unmarshalling a quoted JSON string
+// whose value is not in the list of defined search result kinds.
+var ErrUnknownSearchKind = errors.New("unknown search result kind")
+
+// SearchResult represents a single search result. Kind determines which of
+// Hero, Artifact and Item is set.
+type SearchResult struct {
+	Kind     SearchKind
+	Hero     *Hero
+	Artifact *Artifact
+	Item     *Item
+}
+
+// Name returns the name of the entity the result refers to.
+func (r SearchResult) Name() string {
+	switch {
+	case r.Hero != nil:
+		return r.Hero.Name
+	case r.Artifact != nil:
+		return r.Artifact.Name
+	case r.Item != nil:
+		return r.Item.Name
+	default:
+		return ""
+	}
+}
+
+// ErrMissingSearchKind is returned when unmarshalling a search result object
+// that has no "type" field.
+var ErrMissingSearchKind = errors.New("search result has no type")
+
+// UnmarshalJSON unmarshals a search result object, whose "type" field holds
+// its kind and whose other fields are those of the entity.
+func (r *SearchResult) UnmarshalJSON(b []byte) error {
+	var kind struct {
+		Kind *SearchKind `json:"type"`
+	}
+	if err := json.Unmarshal(b, &kind); err != nil {
+		return err
+	}
+	if kind.Kind == nil {
+		return ErrMissingSearchKind
+	}
+
+	*r = SearchResult{Kind: *kind.Kind}
+	switch *kind.Kind {
+	case HeroResult:
+		r.Hero = new(Hero)
+		return json.Unmarshal(b, r.Hero)
+	case ArtifactResult:
+		r.Artifact = new(Artifact)
+		return json.Unmarshal(b, r.Artifact)
+	case ItemResult:
+		r.Item = new(Item)
+		return json.Unmarshal(b, r.Item)
+	}
+	return nil
+}
+
+// SearchResponse is an EpicSevenDB API response that contains a list of
+// search results.
+type SearchResponse struct {
+	Results  []SearchResult `json:"results,omitempty"`
+	Metadata Metadata       `json:"meta,omitempty"`
+}
+
+func (r *SearchResponse) metadata() Metadata {
+	return r.Metadata
+}
+
+// Search searches heroes, artifacts and items for query.
+//
+// If the API has no search endpoint, Search falls back to fetching every
+// hero, artifact and item and matching query against their names and IDs
+// locally, case-insensitively. Heroes are then fetched as summaries, so the
+// Hero of a result only has the fields of a HeroSummary. Results list heroes
+// first, then artifacts, then items, and the returned Response is that of
+// the last list request. The client remembers that the endpoint is missing,
+// so later searches fall back without requesting it again.
+//
+// A 404 response from the search endpoint itself means that nothing
+// matched query, and is reported as no results.
+func (s *SearchService) Search(ctx context.Context, query string) ([]SearchResult, *Response, error) {
+	if s.client.noSearchEndpoint() {
+		return s.searchLocal(ctx, query)
+	}
+
+	u := "search?" + url.Values{"q": {query}}.Encode()
+	req, err := s.client.NewRequest(http.MethodGet, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response := new(SearchResponse)
+	resp, err := s.client.Do(ctx, req, response)
+	if endpointMissing(err) {
+		s.client.setNoSearchEndpoint()
+		return s.searchLocal(ctx, query)
+	}
+	if errors.Is(err, ErrNotFound) {
+		return nil, resp, nil
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return response.Results, resp, nil
+}
+
+// endpointMissing reports whether err is a 404 response that was not sent
+// by an API handler, which includes an error message or metadata in its
+// body, meaning that the requested endpoint does not exist.
+func endpointMissing(err error) bool {
+	var nf *NotFoundError
+	if !errors.As(err, &nf) || nf.ErrorResponse == nil {
+		return false
+	}
+	return nf.Message == "" && nf.Metadata == (Metadata{})
+}
+
+func (c *Client) noSearchEndpoint() bool {
+	c.searchMu.Lock()
+	defer c.searchMu.Unlock()
+	return c.searchMissing
+}
+
+func (c *Client) setNoSearchEndpoint() {
+	c.searchMu.Lock()
+	defer c.searchMu.Unlock()
+	c.searchMissing = true
+}
+
+// searchLocal implements Search by listing every entity.
+func (s *SearchService) searchLocal(ctx context.Context, query string) ([]SearchResult, *Response, error) {
+	heroes, resp, err := s.client.Heroes.ListSummaries(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	artifacts, resp, err := s.client.Artifacts.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	items, resp, err := s.client.Items.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return localSearch(query, heroes, artifacts, items), resp, nil
+}
+
+// localSearch returns the heroes, artifacts and items whose name or ID
+// contains query, case-insensitively.
+func localSearch(query string, heroes []HeroSummary, artifacts []Artifact, items []Item) []SearchResult {
+	q := strings.ToLower(strings.TrimSpace(query))
+	if q == "" {
+		return nil
+	}
+	matches := func(fields ...string) bool {
+		for _, f := range fields {
+			if strings.Contains(strings.ToLower(f), q) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var results []SearchResult
+	for i := range heroes {
+		if h := heroes[i]; matches(h.Name, h.UUID) {
+			results = append(results, SearchResult{Kind: HeroResult, Hero: h.hero()})
+		}
+	}
+	for i := range artifacts {
+		if matches(artifacts[i].Name, artifacts[i].UUID) {
+			results = append(results, SearchResult{Kind: ArtifactResult, Artifact: &artifacts[i]})
+		}
+	}
+	for i := range items {
+		if matches(items[i].Name, items[i].Identifier) {
+			results = append(results, SearchResult{Kind: ItemResult, Item: &items[i]})
+		}
+	}
+	return results
+}
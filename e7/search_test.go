@@ -0,0 +1,193 @@
+package e7
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSearchService_Search(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.Query().Get("q"), "abyssal crown"; got != want {
+			t.Errorf("search query is %q, want %q", got, want)
+		}
+		fmt.Fprint(w, `
+		{
+			"results": [
+				{"type": "hero", "_id": "h", "name": "H"},
+				{"type": "artifact", "_id": "a", "name": "A"},
+				{"type": "item", "identifier": "i", "name": "I", "category": "material"}
+			],
+			"meta": {
+				"requestDate": "date",
+				"apiVersion": "1"
+			}
+		}`)
+	})
+
+	ctx := context.Background()
+	got, _, err := client.Search.Search(ctx, "abyssal crown")
+	if err != nil {
+		t.Errorf("Search.Search returned error: %v", err)
+	}
+
+	want := []SearchResult{
+		{Kind: HeroResult, Hero: &Hero{UUID: "h", Name: "H"}},
+		{Kind: ArtifactResult, Artifact: &Artifact{UUID: "a", Name: "A"}},
+		{Kind: ItemResult, Item: &Item{Identifier: "i", Name: "I", Category: Material}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Search.Search mismatch (-want +got):\n%s", diff)
+	}
+	for i, name := range []string{"H", "A", "I"} {
+		if got[i].Name() != name {
+			t.Errorf("SearchResult.Name() = %q, want %q", got[i].Name(), name)
+		}
+	}
+
+	// Test s.client.NewRequest failure
+	client.BaseURL.Path = ""
+	got, resp, err := client.Search.Search(ctx, "h")
+	if got != nil {
+		t.Errorf("client.BaseURL.Path='' Search = %#v, want nil", got)
+	}
+	if resp != nil {
+		t.Errorf("client.BaseURL.Path='' Search resp = %#v, want nil", resp)
+	}
+	if err == nil {
+		t.Error("client.BaseURL.Path='' Search err = nil, want error")
+	}
+}
+
+func TestSearchService_Search_unknownKind(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [{"type": "pet", "name": "P"}]}`)
+	})
+
+	_, _, err := client.Search.Search(context.Background(), "p")
+	if !errors.Is(err, ErrUnknownSearchKind) {
+		t.Errorf("Search.Search err = %v, want ErrUnknownSearchKind", err)
+	}
+}
+
+func TestSearchService_Search_missingKind(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [{"_id": "durandal", "name": "Durandal"}]}`)
+	})
+
+	_, _, err := client.Search.Search(context.Background(), "durandal")
+	if !errors.Is(err, ErrMissingSearchKind) {
+		t.Errorf("Search.Search err = %v, want ErrMissingSearchKind", err)
+	}
+}
+
+func TestSearchService_Search_localFallback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	searches := 0
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		searches++
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [
+			{"_id": "montmorancy", "name": "Montmorancy"},
+			{"_id": "angelic-montmorancy", "name": "Angelic Montmorancy"},
+			{"_id": "aramintha", "name": "Aramintha"}
+		]}`)
+	})
+	mux.HandleFunc("/artifact", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [{"_id": "durandal", "name": "Durandal"}]}`)
+	})
+	mux.HandleFunc("/item", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [{"identifier": "montmo-doll", "name": "Doll", "category": "special"}]}`)
+	})
+
+	got, _, err := client.Search.Search(context.Background(), "MONTMO")
+	if err != nil {
+		t.Fatalf("Search.Search returned error: %v", err)
+	}
+
+	var names []string
+	for _, r := range got {
+		names = append(names, r.Kind.String()+":"+r.Name())
+	}
+	want := []string{"hero:Montmorancy", "hero:Angelic Montmorancy", "item:Doll"}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Errorf("Search.Search fallback mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, _, err := client.Search.Search(context.Background(), "durandal"); err != nil {
+		t.Fatalf("Search.Search returned error: %v", err)
+	}
+	if searches != 1 {
+		t.Errorf("search endpoint was called %d times, want 1", searches)
+	}
+}
+
+func TestSearchService_Search_noMatches(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": "No results found", "meta": {"apiVersion": "2.1.0"}}`)
+	})
+	for _, path := range []string{"/hero", "/artifact", "/item"} {
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("Search.Search fell back to listing %v", r.URL.Path)
+		})
+	}
+
+	got, resp, err := client.Search.Search(context.Background(), "nothing")
+	if err != nil {
+		t.Fatalf("Search.Search returned error: %v", err)
+	}
+	if got != nil {
+		t.Errorf("Search.Search = %v, want no results", got)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Search.Search response = %v, want the 404 response", resp)
+	}
+}
+
+func TestLocalSearch_emptyQuery(t *testing.T) {
+	if got := localSearch("  ", []HeroSummary{{Name: "H"}}, nil, nil); got != nil {
+		t.Errorf("localSearch with empty query = %v, want nil", got)
+	}
+}
+
+func TestSearchKind_JSON(t *testing.T) {
+	for k, s := range searchKindStrings {
+		b, err := k.MarshalJSON()
+		if err != nil {
+			t.Errorf("SearchKind.MarshalJSON returned error: %v", err)
+		}
+		if got, want := string(b), `"`+s+`"`; got != want {
+			t.Errorf("SearchKind.MarshalJSON = %s, want %s", got, want)
+		}
+
+		got := new(SearchKind)
+		if err := got.UnmarshalJSON(b); err != nil {
+			t.Errorf("SearchKind.UnmarshalJSON returned error: %v", err)
+		}
+		if *got != k {
+			t.Errorf("SearchKind.UnmarshalJSON = %v, want %v", *got, k)
+		}
+	}
+}
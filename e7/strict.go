@@ -0,0 +1,150 @@
+package e7
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// WithStrictDecoding enables detection of response fields that are not
+// modeled by the Go types they are decoded into. Responses are still decoded
+// as usual; the JSON paths of unknown fields are stored in
+// Response.UnknownFields and, if onUnknown is non-nil, reported to it along
+// with the request that was sent.
+//
+// Strict decoding buffers every response body in memory.
+func WithStrictDecoding(onUnknown func(req *http.Request, paths []string)) ClientOption {
+	return func(c *Client) error {
+		c.strict = true
+		c.onUnknown = onUnknown
+		return nil
+	}
+}
+
+// UnknownFields returns the JSON paths of every field in data that is not
+// modeled by the type of v, e.g. "results[3].skills[1].new_field". Values
+// decoded by a json.Unmarshaler or into an interface{} are not inspected.
+// Field names are matched like encoding/json does, preferring an exact match
+// but accepting a case-insensitive one.
+//
+// It is useful to detect API schema drift against recorded responses.
+func UnknownFields(data []byte, v interface{}) ([]string, error) {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	var paths []string
+	walkUnknown(raw, reflect.TypeOf(v), "", &paths)
+	return paths, nil
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+func walkUnknown(raw interface{}, t reflect.Type, path string, paths *[]string) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() == reflect.Interface || reflect.PtrTo(t).Implements(unmarshalerType) {
+		return
+	}
+
+	switch raw := raw.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(raw))
+		for k := range raw {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			switch t.Kind() {
+			case reflect.Struct:
+				ft, ok := jsonFields(t).lookup(k)
+				if !ok {
+					*paths = append(*paths, p)
+					continue
+				}
+				walkUnknown(raw[k], ft, p, paths)
+			case reflect.Map:
+				walkUnknown(raw[k], t.Elem(), p, paths)
+			}
+		}
+	case []interface{}:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return
+		}
+		for i, elem := range raw {
+			walkUnknown(elem, t.Elem(), path+"["+strconv.Itoa(i)+"]", paths)
+		}
+	}
+}
+
+// fieldSet holds the JSON field names of a struct type and their types.
+type fieldSet map[string]reflect.Type
+
+// lookup returns the type of the field named name, preferring an exact
+// match over a case-insensitive one.
+func (fs fieldSet) lookup(name string) (reflect.Type, bool) {
+	if t, ok := fs[name]; ok {
+		return t, true
+	}
+	for n, t := range fs {
+		if strings.EqualFold(n, name) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+var fieldCache sync.Map // map[reflect.Type]fieldSet
+
+// jsonFields returns the fields of struct type t as seen by encoding/json,
+// including those promoted from embedded structs.
+func jsonFields(t reflect.Type) fieldSet {
+	if fs, ok := fieldCache.Load(t); ok {
+		return fs.(fieldSet)
+	}
+
+	fs := make(fieldSet)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for n, t := range jsonFields(ft) {
+					if _, ok := fs[n]; !ok {
+						fs[n] = t
+					}
+				}
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue // unexported
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fs[name] = f.Type
+	}
+
+	fieldCache.Store(t, fs)
+	return fs
+}
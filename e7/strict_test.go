@@ -0,0 +1,103 @@
+package e7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUnknownFields(t *testing.T) {
+	data := []byte(`{
+		"results": [
+			{"_id": "a", "role": "knight", "NAME": "A"},
+			{
+				"_id": "b",
+				"skills": [{"name": "s", "new_field": 1}, {"name": "t"}],
+				"calculatedStatus": {"lv50FiveStarNoAwaken": {"atk": 1, "new_stat": 2}},
+				"relationships": [{"upgrade": {"anything": true}}]
+			}
+		],
+		"meta": {"requestDate": "date", "extra": true},
+		"extra": []
+	}`)
+
+	got, err := UnknownFields(data, new(HeroesResponse))
+	if err != nil {
+		t.Fatalf("UnknownFields returned error: %v", err)
+	}
+
+	want := []string{
+		"extra",
+		"results[1].calculatedStatus.lv50FiveStarNoAwaken.new_stat",
+		"results[1].skills[0].new_field",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("UnknownFields mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestUnknownFields_embedded(t *testing.T) {
+	got, err := UnknownFields([]byte(`{"_id": "b", "name": "n", "icon": "i"}`), new(Buff))
+	if err != nil {
+		t.Fatalf("UnknownFields returned error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"icon"}, got); diff != "" {
+		t.Errorf("UnknownFields mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestUnknownFields_invalidJSON(t *testing.T) {
+	if _, err := UnknownFields([]byte(`{`), new(Hero)); err == nil {
+		t.Error("UnknownFields err = nil, want error")
+	}
+}
+
+func TestDo_strictDecoding(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var reported []string
+	WithStrictDecoding(func(req *http.Request, paths []string) {
+		reported = paths
+	})(client)
+
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [{"_id": "h", "new_field": 1}]}`)
+	})
+
+	got, resp, err := client.Heroes.List(context.Background())
+	if err != nil {
+		t.Fatalf("Heroes.List returned error: %v", err)
+	}
+	if want := []Hero{{UUID: "h"}}; !cmp.Equal(want, got) {
+		t.Errorf("Heroes.List mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+
+	want := []string{"results[0].new_field"}
+	if diff := cmp.Diff(want, resp.UnknownFields); diff != "" {
+		t.Errorf("Response.UnknownFields mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(want, reported); diff != "" {
+		t.Errorf("reported unknown fields mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDo_notStrict(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/hero", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results": [{"_id": "h", "new_field": 1}]}`)
+	})
+
+	_, resp, err := client.Heroes.List(context.Background())
+	if err != nil {
+		t.Fatalf("Heroes.List returned error: %v", err)
+	}
+	if resp.UnknownFields != nil {
+		t.Errorf("Response.UnknownFields = %v, want nil without strict decoding", resp.UnknownFields)
+	}
+}
@@ -0,0 +1,79 @@
+package e7
+
+import "errors"
+
+// ErrInvalidAwakeningRange is returned by Hero.AwakeningCost when the
+// awakening range is not within the hero's zodiac tree.
+var ErrInvalidAwakeningRange = errors.New("invalid awakening range")
+
+// AwakeningCost represents the materials needed to awaken a hero over a
+// range of zodiac nodes, and the stats gained.
+type AwakeningCost struct {
+	// From and To are the awakening range, as passed to
+	// Hero.AwakeningCost.
+	From, To int
+
+	// Materials are the total costs of every item, including runes,
+	// catalysts and currencies, in the order the items are first needed.
+	Materials []MaterialCost
+
+	// Runes and Catalysts are the costs of Materials that are runes and
+	// catalysts, and RuneCount and CatalystCount their total counts.
+	Runes         []MaterialCost
+	Catalysts     []MaterialCost
+	RuneCount     int
+	CatalystCount int
+
+	// Stats are the stats gained, summed by stat and type.
+	Stats []NodeStat
+}
+
+// AwakeningCost returns the materials needed to awaken h from awakening
+// level from to level to, e.g. from 3 to 6, and the stats gained. Awakening
+// level n is reached by unlocking the first n nodes of h.ZodiacTree, so the
+// nodes from from to to-1 are counted.
+//
+// If the range is not within 0 and len(h.ZodiacTree), or from is greater
+// than to, the returned error is ErrInvalidAwakeningRange.
+func (h *Hero) AwakeningCost(from, to int) (*AwakeningCost, error) {
+	if from < 0 || from > to || to > len(h.ZodiacTree) {
+		return nil, ErrInvalidAwakeningRange
+	}
+
+	var materials materialTotals
+	cost := &AwakeningCost{From: from, To: to}
+	for _, node := range h.ZodiacTree[from:to] {
+		for _, c := range node.Costs {
+			materials.add(MaterialCost{
+				Identifier: c.Identifier,
+				Name:       c.Name,
+				Category:   ItemCategory(c.Category),
+				Grade:      c.Grade,
+				Attribute:  c.Attribute,
+				Count:      c.Count,
+			})
+		}
+		for _, s := range node.Stats {
+			cost.Stats = addNodeStat(cost.Stats, s)
+		}
+	}
+
+	cost.Materials = materials.costs
+	cost.Runes = materials.category(Rune)
+	cost.Catalysts = materials.category(Catalyst)
+	cost.RuneCount = sumCounts(cost.Runes)
+	cost.CatalystCount = sumCounts(cost.Catalysts)
+	return cost, nil
+}
+
+// addNodeStat adds s to stats, summing it with a stat of the same stat and
+// type if there is one.
+func addNodeStat(stats []NodeStat, s NodeStat) []NodeStat {
+	for i := range stats {
+		if stats[i].Stat == s.Stat && stats[i].Type == s.Type {
+			stats[i].Value += s.Value
+			return stats
+		}
+	}
+	return append(stats, s)
+}
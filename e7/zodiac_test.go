@@ -0,0 +1,87 @@
+package e7
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func zodiacTestHero() *Hero {
+	fire := Fire
+	gold := func(n int) NodeCost {
+		return NodeCost{Identifier: "gold", Name: "Gold", Category: "currency", Count: n}
+	}
+	runeCost := func(id string, grade uint, n int) NodeCost {
+		return NodeCost{Identifier: id, Name: id, Category: "rune", Grade: grade, Attribute: &fire, Count: n}
+	}
+	catalyst := func(id string, n int) NodeCost {
+		return NodeCost{Identifier: id, Name: id, Category: "catalyst", Grade: 3, Count: n}
+	}
+
+	return &Hero{ZodiacTree: []ZodiacNode{
+		{Costs: []NodeCost{gold(1000), runeCost("fire-rune-1", 1, 3)}, Stats: []NodeStat{{Stat: Attack, Value: 10, Type: "flat"}}},
+		{Costs: []NodeCost{gold(2000), runeCost("fire-rune-1", 1, 5)}, Stats: []NodeStat{{Stat: Health, Value: 50, Type: "flat"}}},
+		{Costs: []NodeCost{gold(5000), runeCost("fire-rune-2", 2, 2)}, Stats: []NodeStat{{Stat: Attack, Value: 20, Type: "flat"}}},
+		{Costs: []NodeCost{gold(10000), runeCost("fire-rune-2", 2, 4), catalyst("blazing-rage", 5)}, Stats: []NodeStat{{Stat: AttackPercent, Value: 0.05, Type: "percent"}}},
+		{Costs: []NodeCost{gold(20000), runeCost("fire-rune-3", 3, 3), catalyst("blazing-rage", 10)}, Stats: []NodeStat{{Stat: Attack, Value: 30, Type: "flat"}}},
+		{Costs: []NodeCost{gold(30000), runeCost("fire-rune-3", 3, 5), catalyst("demon-blood-gem", 10)}, Stats: []NodeStat{{Stat: AttackPercent, Value: 0.05, Type: "percent"}}},
+	}}
+}
+
+func TestHero_AwakeningCost(t *testing.T) {
+	h := zodiacTestHero()
+	fire := Fire
+
+	got, err := h.AwakeningCost(3, 6)
+	if err != nil {
+		t.Fatalf("Hero.AwakeningCost returned error: %v", err)
+	}
+
+	runes := []MaterialCost{
+		{Identifier: "fire-rune-2", Name: "fire-rune-2", Category: Rune, Grade: 2, Attribute: &fire, Count: 4},
+		{Identifier: "fire-rune-3", Name: "fire-rune-3", Category: Rune, Grade: 3, Attribute: &fire, Count: 8},
+	}
+	catalysts := []MaterialCost{
+		{Identifier: "blazing-rage", Name: "blazing-rage", Category: Catalyst, Grade: 3, Count: 15},
+		{Identifier: "demon-blood-gem", Name: "demon-blood-gem", Category: Catalyst, Grade: 3, Count: 10},
+	}
+	want := &AwakeningCost{
+		From: 3,
+		To:   6,
+		Materials: []MaterialCost{
+			{Identifier: "gold", Name: "Gold", Category: Currency, Count: 60000},
+			runes[0], catalysts[0], runes[1], catalysts[1],
+		},
+		Runes:         runes,
+		Catalysts:     catalysts,
+		RuneCount:     12,
+		CatalystCount: 25,
+		Stats: []NodeStat{
+			{Stat: AttackPercent, Value: 0.1, Type: "percent"},
+			{Stat: Attack, Value: 30, Type: "flat"},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Hero.AwakeningCost mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestHero_AwakeningCost_emptyRange(t *testing.T) {
+	got, err := zodiacTestHero().AwakeningCost(2, 2)
+	if err != nil {
+		t.Fatalf("Hero.AwakeningCost returned error: %v", err)
+	}
+	if diff := cmp.Diff(&AwakeningCost{From: 2, To: 2}, got); diff != "" {
+		t.Errorf("Hero.AwakeningCost mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestHero_AwakeningCost_invalidRange(t *testing.T) {
+	h := zodiacTestHero()
+	for _, r := range [][2]int{{-1, 3}, {4, 3}, {0, 7}} {
+		if _, err := h.AwakeningCost(r[0], r[1]); !errors.Is(err, ErrInvalidAwakeningRange) {
+			t.Errorf("Hero.AwakeningCost(%d, %d) err = %v, want ErrInvalidAwakeningRange", r[0], r[1], err)
+		}
+	}
+}